@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// CynoJammerList keeps track of solar systems in which cynosural fields can not be lit.
+// Entries may have an expiry time, after which they are no longer considered.
+type CynoJammerList struct {
+	mutex   sync.RWMutex
+	expires map[universe.Id]time.Time
+}
+
+func NewCynoJammerList() *CynoJammerList {
+	list := &CynoJammerList{
+		expires: make(map[universe.Id]time.Time)}
+
+	return list
+}
+
+// LoadFile adds all entries of given JSON file, which contains a list of api.CynoJammerEntry.
+func (list *CynoJammerList) LoadFile(fileName string) error {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+	var entries []api.CynoJammerEntry
+	err = json.Unmarshal(content, &entries)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		list.Add(entry.SolarSystem, entry.Expires)
+	}
+
+	return nil
+}
+
+// Add registers given solar system as jammed. A nil expiry keeps the entry until it is removed.
+func (list *CynoJammerList) Add(solarSystemId universe.Id, expires *time.Time) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	var expiry time.Time
+	if expires != nil {
+		expiry = *expires
+	}
+	list.expires[solarSystemId] = expiry
+}
+
+func (list *CynoJammerList) Remove(solarSystemId universe.Id) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	delete(list.expires, solarSystemId)
+}
+
+// Entries returns all currently active entries, ordered by solar system.
+func (list *CynoJammerList) Entries() []api.CynoJammerEntry {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+
+	list.dropExpired(time.Now())
+	entries := make([]api.CynoJammerEntry, 0, len(list.expires))
+	for solarSystemId, expiry := range list.expires {
+		entry := api.CynoJammerEntry{SolarSystem: solarSystemId}
+
+		if !expiry.IsZero() {
+			expires := expiry
+			entry.Expires = &expires
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].SolarSystem < entries[j].SolarSystem })
+
+	return entries
+}
+
+// JammedSystems returns a snapshot of the currently jammed solar systems.
+func (list *CynoJammerList) JammedSystems() map[universe.Id]bool {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	now := time.Now()
+	result := make(map[universe.Id]bool)
+	for solarSystemId, expiry := range list.expires {
+		if expiry.IsZero() || expiry.After(now) {
+			result[solarSystemId] = true
+		}
+	}
+
+	return result
+}
+
+func (list *CynoJammerList) dropExpired(now time.Time) {
+	for solarSystemId, expiry := range list.expires {
+		if !expiry.IsZero() && !expiry.After(now) {
+			delete(list.expires, solarSystemId)
+		}
+	}
+}
+
+// cynoJammerFilter is used for a single search and keeps the jammed systems at the time the search started.
+type cynoJammerFilter struct {
+	jammedSystems map[universe.Id]bool
+}
+
+func newCynoJammerFilter(jammedSystems map[universe.Id]bool) *cynoJammerFilter {
+	filter := &cynoJammerFilter{
+		jammedSystems: jammedSystems}
+
+	return filter
+}
+
+func (filter *cynoJammerFilter) isAllowed(solarSystemId universe.Id) bool {
+	return !filter.jammedSystems[solarSystemId]
+}
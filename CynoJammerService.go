@@ -0,0 +1,78 @@
+package main
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// CynoJammerService is the administrative interface to maintain the list of cyno jammed systems.
+type CynoJammerService struct {
+	universe universe.Universe
+	list     *CynoJammerList
+}
+
+func NewCynoJammerService(universe universe.Universe, list *CynoJammerList) *CynoJammerService {
+	service := &CynoJammerService{
+		universe: universe,
+		list:     list}
+
+	return service
+}
+
+// Add registers the given systems as jammed. If any of them is unknown, none are added.
+func (service *CynoJammerService) Add(r *http.Request, request *api.CynoJammerAddRequest, response *api.CynoJammerListResponse) error {
+	var err *ServiceError
+	for index, solarSystemId := range request.SolarSystems {
+		if service.universe.SolarSystem(solarSystemId) != nil {
+			continue
+		}
+		field := fmt.Sprintf("solarSystems[%d]", index)
+		if err == nil {
+			err = newServiceError(api.UnknownSystemErrorCode, field, "Unknown solar system %v", solarSystemId)
+		} else {
+			err.add(api.UnknownSystemErrorCode, field, "Unknown solar system %v", solarSystemId)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	for _, solarSystemId := range request.SolarSystems {
+		service.list.Add(solarSystemId, request.Expires)
+	}
+	response.Entries = service.list.Entries()
+
+	return nil
+}
+
+func (service *CynoJammerService) Remove(r *http.Request, request *api.CynoJammerRemoveRequest, response *api.CynoJammerListResponse) error {
+	for _, solarSystemId := range request.SolarSystems {
+		service.list.Remove(solarSystemId)
+	}
+	response.Entries = service.list.Entries()
+
+	return nil
+}
+
+func (service *CynoJammerService) List(r *http.Request, request *api.CynoJammerListRequest, response *api.CynoJammerListResponse) error {
+	response.Entries = service.list.Entries()
+
+	return nil
+}
+
+// adminHandler only passes requests which carry the given token as bearer authorization.
+func adminHandler(handler http.Handler, token string) http.Handler {
+	expected := []byte("Bearer " + token)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
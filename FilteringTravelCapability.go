@@ -0,0 +1,71 @@
+package main
+
+import (
+	"math"
+
+	"github.com/dertseha/everoute/travel"
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// MaxJumpDriveSecurity is the highest (displayed) security a jump drive may target.
+// Cynosural fields can not be lit in high security space.
+const MaxJumpDriveSecurity = 0.4
+
+// displaySecurity returns the security status as shown in game, rounded to one decimal.
+// Any positive true security below 0.05 is shown as 0.1.
+func displaySecurity(trueSec float64) float64 {
+	if (trueSec > 0.0) && (trueSec < 0.05) {
+		return 0.1
+	}
+
+	return math.Floor(trueSec*10.0+0.5) / 10.0
+}
+
+// securityClass returns the api security class for given displayed security.
+func securityClass(security float64) string {
+	if security >= 0.5 {
+		return api.HighSecClass
+	} else if security > 0.0 {
+		return api.LowSecClass
+	}
+
+	return api.NullSecClass
+}
+
+type filteringTravelCapability struct {
+	capability travel.TravelCapability
+	isAllowed  func(solarSystemId universe.Id) bool
+}
+
+// FilteringTravelCapability wraps given capability and drops all paths that would enter
+// a solar system for which the predicate returns false.
+func FilteringTravelCapability(capability travel.TravelCapability, isAllowed func(solarSystemId universe.Id) bool) travel.TravelCapability {
+	return &filteringTravelCapability{
+		capability: capability,
+		isAllowed:  isAllowed}
+}
+
+func (filter *filteringTravelCapability) NextPaths(origin travel.Path) []travel.Path {
+	result := make([]travel.Path, 0)
+
+	for _, path := range filter.capability.NextPaths(origin) {
+		if filter.isAllowed(path.Step().SolarSystemId()) {
+			result = append(result, path)
+		}
+	}
+
+	return result
+}
+
+// SecurityFilteringTravelCapability wraps given capability and drops all paths that
+// would enter a solar system with a display security outside the given limits.
+func SecurityFilteringTravelCapability(verse universe.Universe, capability travel.TravelCapability,
+	minSecurity, maxSecurity float64) travel.TravelCapability {
+	return FilteringTravelCapability(capability, func(solarSystemId universe.Id) bool {
+		security := displaySecurity(float64(verse.SolarSystem(solarSystemId).TrueSecurity()))
+
+		return (security >= minSecurity) && (security <= maxSecurity)
+	})
+}
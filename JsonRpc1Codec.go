@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/rpc"
+	rpcJson "github.com/gorilla/rpc/json"
+)
+
+// JsonRpc1Codec is the JSON-RPC 1.0 codec of gorilla, which reports service errors
+// as error objects instead of plain strings. Other errors are reported as before.
+type JsonRpc1Codec struct {
+	codec *rpcJson.Codec
+}
+
+func NewJsonRpc1Codec() *JsonRpc1Codec {
+	codec := &JsonRpc1Codec{codec: rpcJson.NewCodec()}
+
+	return codec
+}
+
+type jsonRpc1CodecRequest struct {
+	rpc.CodecRequest
+	id *json.RawMessage
+}
+
+type jsonRpc1Response struct {
+	Result interface{}      `json:"result"`
+	Error  interface{}      `json:"error"`
+	Id     *json.RawMessage `json:"id"`
+}
+
+// NewRequest keeps the id of the request, so that error responses can be written without the wrapped codec.
+func (codec *JsonRpc1Codec) NewRequest(r *http.Request) rpc.CodecRequest {
+	var header struct {
+		Id *json.RawMessage `json:"id"`
+	}
+	body, err := io.ReadAll(r.Body)
+	if err == nil {
+		json.Unmarshal(body, &header)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	return &jsonRpc1CodecRequest{CodecRequest: codec.codec.NewRequest(r), id: header.Id}
+}
+
+func (request *jsonRpc1CodecRequest) WriteResponse(w http.ResponseWriter, reply interface{}, methodErr error) error {
+	var serviceErr *ServiceError
+
+	if !errors.As(methodErr, &serviceErr) {
+		return request.CodecRequest.WriteResponse(w, reply, methodErr)
+	}
+	if request.id == nil {
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	return json.NewEncoder(w).Encode(&jsonRpc1Response{Error: serviceErr.response(), Id: request.id})
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sync"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// Error codes as defined by the JSON-RPC 2.0 specification.
+const (
+	JsonRpcParseError     = -32700
+	JsonRpcInvalidRequest = -32600
+	JsonRpcMethodNotFound = -32601
+	JsonRpcInvalidParams  = -32602
+	JsonRpcInternalError  = -32603
+	JsonRpcServerError    = -32000
+)
+
+const maxJsonRpcBatchSize = 100
+
+// JsonRpcError is the error object of a JSON-RPC 2.0 response. Service methods may return
+// it directly to control the reported code and data.
+type JsonRpcError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (err *JsonRpcError) Error() string {
+	return err.Message
+}
+
+// jsonRpc2Request keeps the id as raw message: An explicit null is kept as such,
+// only an absent id makes the request a notification.
+type jsonRpc2Request struct {
+	Version string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	Id      json.RawMessage `json:"id"`
+}
+
+type jsonRpc2Response struct {
+	Version string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *JsonRpcError   `json:"error,omitempty"`
+	Id      json.RawMessage `json:"id"`
+}
+
+type jsonRpc2Method struct {
+	receiver  reflect.Value
+	method    reflect.Method
+	argsType  reflect.Type
+	replyType reflect.Type
+}
+
+var (
+	httpRequestType = reflect.TypeOf((*http.Request)(nil))
+	errorType       = reflect.TypeOf((*error)(nil)).Elem()
+
+	jsonRpc2VersionPattern = regexp.MustCompile(`"jsonrpc"\s*:\s*"2\.0"`)
+)
+
+// JsonRpc2Server handles JSON-RPC 2.0 requests, including batches. Services follow the same
+// method conventions as for the gorilla RPC server:
+//
+//	func (service *T) Method(r *http.Request, args *Args, reply *Reply) error
+//
+// Requests that are not marked as version 2.0 are passed on to the fallback handler.
+type JsonRpc2Server struct {
+	fallback http.Handler
+	methods  map[string]*jsonRpc2Method
+}
+
+func NewJsonRpc2Server(fallback http.Handler) *JsonRpc2Server {
+	server := &JsonRpc2Server{
+		fallback: fallback,
+		methods:  make(map[string]*jsonRpc2Method)}
+
+	return server
+}
+
+// RegisterService makes all suitable methods of the receiver available as "name.Method".
+func (server *JsonRpc2Server) RegisterService(receiver interface{}, name string) error {
+	receiverValue := reflect.ValueOf(receiver)
+	receiverType := receiverValue.Type()
+	registered := 0
+
+	for i := 0; i < receiverType.NumMethod(); i++ {
+		method := receiverType.Method(i)
+		methodType := method.Type
+
+		if (methodType.NumIn() != 4) || (methodType.NumOut() != 1) ||
+			(methodType.In(1) != httpRequestType) ||
+			(methodType.In(2).Kind() != reflect.Ptr) || (methodType.In(3).Kind() != reflect.Ptr) ||
+			(methodType.Out(0) != errorType) {
+			continue
+		}
+		server.methods[name+"."+method.Name] = &jsonRpc2Method{
+			receiver:  receiverValue,
+			method:    method,
+			argsType:  methodType.In(2).Elem(),
+			replyType: methodType.In(3).Elem()}
+		registered++
+	}
+	if registered == 0 {
+		return fmt.Errorf("Type %v has no exported methods of suitable type", receiverType)
+	}
+
+	return nil
+}
+
+func (server *JsonRpc2Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		server.fallback.ServeHTTP(w, r)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	trimmed := bytes.TrimSpace(body)
+
+	if (len(trimmed) > 0) && (trimmed[0] == '[') {
+		server.serveBatch(w, r, trimmed)
+	} else if isJsonRpc2Request(trimmed) {
+		response := server.handleRawRequest(r, trimmed)
+		if response != nil {
+			writeJsonResponse(w, http.StatusOK, response)
+		} else {
+			w.WriteHeader(http.StatusNoContent)
+		}
+	} else {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		server.fallback.ServeHTTP(w, r)
+	}
+}
+
+// isJsonRpc2Request returns whether the body is marked as version 2.0. Bodies which can not be parsed
+// are recognized by the textual version member, so that they are answered with a parse error.
+func isJsonRpc2Request(body []byte) bool {
+	var header struct {
+		Version string `json:"jsonrpc"`
+	}
+
+	if (len(body) == 0) || (body[0] != '{') {
+		return false
+	}
+	if !json.Valid(body) {
+		return jsonRpc2VersionPattern.Match(body)
+	}
+	return (json.Unmarshal(body, &header) == nil) && (header.Version == "2.0")
+}
+
+func (server *JsonRpc2Server) serveBatch(w http.ResponseWriter, r *http.Request, body []byte) {
+	var batch []json.RawMessage
+
+	if err := json.Unmarshal(body, &batch); err != nil {
+		writeJsonResponse(w, http.StatusOK, errorResponse(nil, &JsonRpcError{Code: JsonRpcParseError, Message: err.Error()}))
+		return
+	}
+	if len(batch) == 0 {
+		writeJsonResponse(w, http.StatusOK, errorResponse(nil, &JsonRpcError{Code: JsonRpcInvalidRequest, Message: "Empty batch"}))
+		return
+	}
+	if len(batch) > maxJsonRpcBatchSize {
+		writeJsonResponse(w, http.StatusOK, errorResponse(nil, &JsonRpcError{Code: JsonRpcInvalidRequest,
+			Message: fmt.Sprintf("Batch exceeds limit of %d requests", maxJsonRpcBatchSize)}))
+		return
+	}
+
+	responses := make([]*jsonRpc2Response, len(batch))
+	limiter := make(chan bool, runtime.NumCPU())
+	var waitGroup sync.WaitGroup
+	for index, rawRequest := range batch {
+		waitGroup.Add(1)
+		go func(index int, rawRequest json.RawMessage) {
+			defer waitGroup.Done()
+			limiter <- true
+			responses[index] = server.handleRawRequest(r, rawRequest)
+			<-limiter
+		}(index, rawRequest)
+	}
+	waitGroup.Wait()
+
+	result := make([]*jsonRpc2Response, 0, len(responses))
+	for _, response := range responses {
+		if response != nil {
+			result = append(result, response)
+		}
+	}
+	if len(result) > 0 {
+		writeJsonResponse(w, http.StatusOK, result)
+	} else {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// errorResponse creates the response for given error. Without id, the id is reported as null.
+func errorResponse(id json.RawMessage, err *JsonRpcError) *jsonRpc2Response {
+	return &jsonRpc2Response{Version: "2.0", Error: err, Id: id}
+}
+
+// handleRawRequest processes a single request. It returns nil for notifications.
+func (server *JsonRpc2Server) handleRawRequest(r *http.Request, rawRequest json.RawMessage) *jsonRpc2Response {
+	var request jsonRpc2Request
+
+	if !json.Valid(rawRequest) {
+		return errorResponse(nil, &JsonRpcError{Code: JsonRpcParseError, Message: "Parse error"})
+	}
+	if err := json.Unmarshal(rawRequest, &request); err != nil {
+		return errorResponse(nil, &JsonRpcError{Code: JsonRpcInvalidRequest, Message: err.Error()})
+	}
+	if (request.Version != "2.0") || (request.Method == "") {
+		return errorResponse(request.Id, &JsonRpcError{Code: JsonRpcInvalidRequest, Message: "Not a JSON-RPC 2.0 request"})
+	}
+
+	result, err := server.call(r, &request)
+	if len(request.Id) == 0 {
+		return nil
+	}
+	if err != nil {
+		return errorResponse(request.Id, err)
+	}
+
+	return &jsonRpc2Response{Version: "2.0", Result: result, Id: request.Id}
+}
+
+func (server *JsonRpc2Server) call(r *http.Request, request *jsonRpc2Request) (result interface{}, rpcErr *JsonRpcError) {
+	method, found := server.methods[request.Method]
+	if !found {
+		return nil, &JsonRpcError{Code: JsonRpcMethodNotFound, Message: fmt.Sprintf("Method <%s> not found", request.Method)}
+	}
+
+	args := reflect.New(method.argsType)
+	if err := decodeJsonRpc2Params(request.Params, args.Interface()); err != nil {
+		serviceErr := newServiceError(api.InvalidParameterErrorCode, "", "%s", err.Error())
+		return nil, &JsonRpcError{Code: JsonRpcInvalidParams, Message: serviceErr.Error(), Data: serviceErr.response()}
+	}
+	reply := reflect.New(method.replyType)
+
+	defer func() {
+		if panic := recover(); panic != nil {
+			log.Printf("Panic in <%s>: %v", request.Method, panic)
+			result = nil
+			serviceErr := newServiceError(api.InternalErrorCode, "", "%v", panic)
+			rpcErr = &JsonRpcError{Code: JsonRpcInternalError, Message: serviceErr.Error(), Data: serviceErr.response()}
+		}
+	}()
+	returned := method.method.Func.Call([]reflect.Value{method.receiver, reflect.ValueOf(r), args, reply})
+	if errValue := returned[0].Interface(); errValue != nil {
+		err := errValue.(error)
+		var typedErr *JsonRpcError
+
+		if errors.As(err, &typedErr) {
+			return nil, typedErr
+		}
+		serviceErr := serviceErrorOf(err)
+		return nil, &JsonRpcError{Code: serviceErr.jsonRpcCode(), Message: serviceErr.Error(), Data: serviceErr.response()}
+	}
+
+	return reply.Interface(), nil
+}
+
+// decodeJsonRpc2Params accepts either the argument object itself (named parameters)
+// or an array with the argument object as single entry (positional parameters).
+func decodeJsonRpc2Params(params json.RawMessage, args interface{}) error {
+	trimmed := bytes.TrimSpace(params)
+
+	if (len(trimmed) == 0) || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	if trimmed[0] == '[' {
+		var positional []json.RawMessage
+
+		if err := json.Unmarshal(trimmed, &positional); err != nil {
+			return err
+		}
+		if len(positional) > 1 {
+			return errors.New("Only one positional parameter is supported")
+		}
+		if len(positional) == 0 {
+			return nil
+		}
+		trimmed = positional[0]
+	}
+
+	return json.Unmarshal(trimmed, args)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"github.com/dertseha/everoute/travel"
+	"github.com/dertseha/everoute/travel/search"
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+type matchingSystemSearchCriterion struct {
+	isMatch func(solarSystemId universe.Id) bool
+}
+
+// MatchingSystemSearchCriterion desires any path ending in a solar system for which isMatch returns true.
+// Combined with a cost aware criterion, the search finds the cheapest of these systems.
+func MatchingSystemSearchCriterion(isMatch func(solarSystemId universe.Id) bool) search.SearchCriterion {
+	criterion := &matchingSystemSearchCriterion{isMatch: isMatch}
+
+	return criterion
+}
+
+func (criterion *matchingSystemSearchCriterion) IsDesired(path travel.Path) bool {
+	return criterion.isMatch(path.Step().SolarSystemId())
+}
+
+func (criterion *matchingSystemSearchCriterion) ShouldSearchContinueWith(path travel.Path, results []travel.Path) bool {
+	return true
+}
+
+// getNearestMatcher returns whether a solar system fulfils all conditions of the nearest entry.
+// Start systems are never a match, the nearest system is always another one.
+func getNearestMatcher(verse universe.Universe, catalog *SolarSystemCatalog, nearest *api.NearestEntry,
+	starts api.SolarSystemIdList) func(solarSystemId universe.Id) bool {
+	excluded := make(map[universe.Id]bool)
+	for _, solarSystemId := range starts {
+		excluded[solarSystemId] = true
+	}
+	classOf := func(solarSystemId universe.Id) string {
+		return securityClass(displaySecurity(float64(verse.SolarSystem(solarSystemId).TrueSecurity())))
+	}
+
+	return func(solarSystemId universe.Id) bool {
+		entry := catalog.Entry(solarSystemId)
+
+		if excluded[solarSystemId] || (entry == nil) {
+			return false
+		}
+		if (nearest.SecurityClass != "") && (classOf(solarSystemId) != nearest.SecurityClass) {
+			return false
+		}
+		if (nearest.RegionId != 0) && (entry.RegionId != nearest.RegionId) {
+			return false
+		}
+		if (nearest.ConstellationId != 0) && (entry.ConstellationId != nearest.ConstellationId) {
+			return false
+		}
+		if nearest.AdjacentSecurityClass != "" {
+			for _, gate := range entry.Gates {
+				if (verse.SolarSystem(gate.DestinationId) != nil) && (classOf(gate.DestinationId) == nearest.AdjacentSecurityClass) {
+					return true
+				}
+			}
+			return false
+		}
+
+		return true
+	}
+}
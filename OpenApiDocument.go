@@ -0,0 +1,251 @@
+package main
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+const openApiSchemaPrefix = "#/components/schemas/"
+
+// schemaGenerator creates JSON schema descriptions of Go types, following their JSON encoding.
+// Named struct types are collected as separate definitions and referenced.
+// The struct tag schema can override the type of a field, and mark it as not required
+// with the option optional, such as `schema:"number,optional"`.
+type schemaGenerator struct {
+	definitions map[string]interface{}
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	generator := &schemaGenerator{
+		definitions: make(map[string]interface{})}
+
+	return generator
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func (generator *schemaGenerator) schemaFor(valueType reflect.Type) map[string]interface{} {
+	if valueType == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	switch valueType.Kind() {
+	case reflect.Ptr:
+		return generator.schemaFor(valueType.Elem())
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": generator.schemaFor(valueType.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": generator.schemaFor(valueType.Elem())}
+	case reflect.Struct:
+		return generator.structReference(valueType)
+	}
+
+	return map[string]interface{}{}
+}
+
+func (generator *schemaGenerator) structReference(structType reflect.Type) map[string]interface{} {
+	name := structType.Name()
+
+	if _, known := generator.definitions[name]; !known {
+		generator.definitions[name] = nil // placeholder against recursion
+		generator.definitions[name] = generator.structSchema(structType)
+	}
+
+	return map[string]interface{}{"$ref": openApiSchemaPrefix + name}
+}
+
+func (generator *schemaGenerator) structSchema(structType reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	required := make([]string, 0)
+
+	generator.addStructFields(structType, properties, &required)
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+func (generator *schemaGenerator) addStructFields(structType reflect.Type, properties map[string]interface{}, required *[]string) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+
+		if field.Anonymous && (name == "") {
+			generator.addStructFields(field.Type, properties, required)
+			continue
+		}
+		if (field.PkgPath != "") || (name == "-") {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		var schema map[string]interface{}
+		schemaTag := strings.Split(field.Tag.Get("schema"), ",")
+		if schemaTag[0] != "" {
+			schema = map[string]interface{}{"type": schemaTag[0]}
+		} else {
+			schema = generator.schemaFor(field.Type)
+		}
+		properties[name] = schema
+		optional := strings.Contains(tag, ",omitempty") || ((len(schemaTag) > 1) && (schemaTag[1] == "optional"))
+		if !optional && (field.Type.Kind() != reflect.Ptr) {
+			*required = append(*required, name)
+		}
+	}
+}
+
+func queryParameter(name string, schemaType string, description string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        name,
+		"in":          "query",
+		"description": description,
+		"schema":      map[string]interface{}{"type": schemaType}}
+}
+
+func jsonContent(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"application/json": map[string]interface{}{"schema": schema}}
+}
+
+func jsonRpcEnvelope(method string, params map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"type":     "object",
+		"required": []string{"method", "params", "id"},
+		"properties": map[string]interface{}{
+			"method": map[string]interface{}{"type": "string", "enum": []string{method}},
+			"params": map[string]interface{}{"type": "array", "items": params, "minItems": 1, "maxItems": 1},
+			"id":     map[string]interface{}{}}}
+}
+
+func jsonRpcResult(result map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"result": result,
+			"error":  map[string]interface{}{},
+			"id":     map[string]interface{}{}}}
+}
+
+// NewOpenApiDocument describes the public HTTP interface of the service.
+// All schemas are derived from the types of the api package.
+func NewOpenApiDocument() map[string]interface{} {
+	generator := newSchemaGenerator()
+	routeFindRequest := generator.schemaFor(reflect.TypeOf(api.RouteFindRequest{}))
+	routeFindResponse := generator.schemaFor(reflect.TypeOf(api.RouteFindResponse{}))
+	errorResponse := generator.schemaFor(reflect.TypeOf(api.ErrorResponse{}))
+	routeStreamMessage := generator.schemaFor(reflect.TypeOf(api.RouteStreamMessage{}))
+	universeGraph := generator.schemaFor(reflect.TypeOf(api.UniverseGraph{}))
+
+	routeResponses := map[string]interface{}{
+		"200": map[string]interface{}{"description": "The found route", "content": jsonContent(routeFindResponse)},
+		"400": map[string]interface{}{"description": "Invalid request", "content": jsonContent(errorResponse)},
+		"500": map[string]interface{}{"description": "Internal error", "content": jsonContent(errorResponse)}}
+
+	optimizeViaParameter := queryParameter("optimizeVia", "string", "Visit the waypoints in the order of lowest costs")
+	optimizeViaParameter["schema"].(map[string]interface{})["enum"] = []string{
+		api.OptimizeViaFixedDestination, api.OptimizeViaReturnToStart, api.OptimizeViaOpenEnd}
+
+	graphFormatParameter := queryParameter("format", "string", "Format of the graph, default json")
+	graphFormatParameter["schema"].(map[string]interface{})["enum"] = []string{GraphFormatJson, GraphFormatGraphML, GraphFormatDot}
+
+	paths := map[string]interface{}{
+		"/": map[string]interface{}{
+			"post": map[string]interface{}{
+				"operationId": "Route.Find",
+				"summary":     "JSON-RPC interface",
+				"requestBody": map[string]interface{}{
+					"required": true,
+					"content":  jsonContent(jsonRpcEnvelope("Route.Find", routeFindRequest))},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{"description": "JSON-RPC response", "content": jsonContent(jsonRpcResult(routeFindResponse))}}}},
+		"/api/route": map[string]interface{}{
+			"get": map[string]interface{}{
+				"operationId": "getRoute",
+				"summary":     "Find a route described by query parameters",
+				"parameters": []interface{}{
+					queryParameter("from", "string", "Comma separated start systems, by name or ID"),
+					queryParameter("via", "string", "Comma separated waypoints, by name or ID"),
+					queryParameter("to", "string", "Destination system, by name or ID"),
+					queryParameter("avoid", "string", "Comma separated systems to avoid, by name or ID"),
+					queryParameter("jumpGate", "boolean", "Use jump gates, default true"),
+					queryParameter("avoidHighSec", "boolean", "Do not use jump gates into high security space"),
+					queryParameter("jumpDrive", "number", "Use a jump drive with given range in light years"),
+					queryParameter("minSecurity", "number", "Prefer systems with at least this security"),
+					queryParameter("maxSecurity", "number", "Prefer systems with at most this security"),
+					queryParameter("prefer", "string", "Comma separated further rules in order of priority"),
+					queryParameter("details", "boolean", "Describe each solar system of the path"),
+					optimizeViaParameter},
+				"responses": routeResponses},
+			"post": map[string]interface{}{
+				"operationId": "postRoute",
+				"summary":     "Find a route",
+				"requestBody": map[string]interface{}{
+					"required": true,
+					"content":  jsonContent(routeFindRequest)},
+				"responses": routeResponses}},
+		"/api/route/stream": map[string]interface{}{
+			"post": map[string]interface{}{
+				"operationId": "streamRoute",
+				"summary":     "Find a route and stream every improvement as Server-Sent Events",
+				"requestBody": map[string]interface{}{
+					"required": true,
+					"content":  jsonContent(routeFindRequest)},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"description": "Events of type route carry the improved route, the final done event the termination reason",
+						"content": map[string]interface{}{
+							"text/event-stream": map[string]interface{}{
+								"schema": map[string]interface{}{"oneOf": []interface{}{routeFindResponse, routeStreamMessage}}}}},
+					"400": routeResponses["400"],
+					"405": map[string]interface{}{"description": "Method not allowed", "content": jsonContent(errorResponse)},
+					"500": routeResponses["500"]}}},
+		"/api/universe/graph": map[string]interface{}{
+			"get": map[string]interface{}{
+				"operationId": "getUniverseGraph",
+				"summary":     "Export the solar systems and their gate jumps as graph",
+				"parameters":  []interface{}{graphFormatParameter, queryParameter("region", "string", "Comma separated regions to limit the graph to, by name or ID")},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"description": "The graph in the requested format",
+						"content": map[string]interface{}{
+							"application/json":        map[string]interface{}{"schema": universeGraph},
+							"application/graphml+xml": map[string]interface{}{"schema": map[string]interface{}{"type": "string"}},
+							"text/vnd.graphviz":       map[string]interface{}{"schema": map[string]interface{}{"type": "string"}}}},
+					"400": map[string]interface{}{"description": "Invalid request", "content": jsonContent(errorResponse)}}}}}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "everoute-web",
+			"version": Version},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": generator.definitions}}
+}
+
+// openApiHandler serves the given document as JSON.
+func openApiHandler(document map[string]interface{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJsonResponse(w, http.StatusOK, document)
+	})
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"testing"
+)
+
+const openApiGoldenFile = "test/openapi.json"
+
+var updateGolden = flag.Bool("update", false, "Update the golden files instead of comparing against them")
+
+// TestOpenApiDocumentIsInSync ensures that changes of the api types are reflected in the published
+// document. After an intended change, run the test with -update and review the difference.
+func TestOpenApiDocumentIsInSync(t *testing.T) {
+	document := NewOpenApiDocument()
+	document["info"].(map[string]interface{})["version"] = "golden"
+	generated, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		t.Fatalf("Failed to encode document: %v", err)
+	}
+	generated = append(generated, '\n')
+
+	if *updateGolden {
+		if err = os.WriteFile(openApiGoldenFile, generated, 0644); err != nil {
+			t.Fatalf("Failed to update <%s>: %v", openApiGoldenFile, err)
+		}
+		return
+	}
+	expected, err := os.ReadFile(openApiGoldenFile)
+	if err != nil {
+		t.Fatalf("Failed to read <%s>: %v", openApiGoldenFile, err)
+	}
+	if !bytes.Equal(expected, generated) {
+		t.Errorf("The OpenAPI document differs from <%s>; Run the test with -update if the change is intended", openApiGoldenFile)
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"container/heap"
+	"context"
+
+	"github.com/dertseha/everoute/travel"
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// unlimitedJumps lets a pathCostSearch explore paths of any length.
+const unlimitedJumps = -1
+
+// reachedSystem is a solar system reached by a pathCostSearch, with the cheapest path to it.
+type reachedSystem struct {
+	solarSystemId universe.Id
+	path          travel.Path
+	costs         []float64
+	jumps         int
+}
+
+type reachedSystemQueue []*reachedSystem
+
+func compareCosts(a, b []float64) float64 {
+	for index := 0; (index < len(a)) && (index < len(b)); index++ {
+		if a[index] != b[index] {
+			return a[index] - b[index]
+		}
+	}
+
+	return 0.0
+}
+
+func (queue reachedSystemQueue) Len() int {
+	return len(queue)
+}
+
+func (queue reachedSystemQueue) Less(i, j int) bool {
+	return compareCosts(queue[i].costs, queue[j].costs) < 0
+}
+
+func (queue reachedSystemQueue) Swap(i, j int) {
+	queue[i], queue[j] = queue[j], queue[i]
+}
+
+func (queue *reachedSystemQueue) Push(value interface{}) {
+	*queue = append(*queue, value.(*reachedSystem))
+}
+
+func (queue *reachedSystemQueue) Pop() interface{} {
+	old := *queue
+	last := old[len(old)-1]
+	*queue = old[:len(old)-1]
+
+	return last
+}
+
+// pathCostSearch explores the universe from one start system in order of the rule costs,
+// reaching every system with its cheapest path. In contrast to the route finder of everoute,
+// which searches a single destination, one exploration serves any number of destinations.
+type pathCostSearch struct {
+	universe   universe.Universe
+	capability travel.TravelCapability
+	rules      priorizedTravelRules
+	avoid      map[universe.Id]bool
+}
+
+func newPathCostSearch(verse universe.Universe, capability travel.TravelCapability, ruleset *api.TravelRuleset, avoid *api.AvoidEntry) *pathCostSearch {
+	costSearch := &pathCostSearch{
+		universe:   verse,
+		capability: capability,
+		rules:      getPriorizedTravelRules(ruleset),
+		avoid:      make(map[universe.Id]bool)}
+
+	if avoid != nil {
+		for _, solarSystemId := range avoid.SolarSystems {
+			costSearch.avoid[solarSystemId] = true
+		}
+	}
+
+	return costSearch
+}
+
+func (costSearch *pathCostSearch) extendedCosts(base []float64, path travel.Path) []float64 {
+	costs := costSearch.rules.stepCosts(path.Step())
+
+	for index := range costs {
+		costs[index] += base[index]
+	}
+
+	return costs
+}
+
+// explore visits all reachable systems in order of their costs, starting with the start systems themselves.
+// Unless maxJumps is unlimitedJumps, only paths of at most that many jumps are considered, and every system is
+// visited with its cheapest path within that limit. For this, a system is extended again whenever a path
+// with fewer jumps arrives later, as it may lead to systems the cheaper path can not reach within the limit.
+// The exploration ends once visit returns false, all reachable systems were visited, or the context is done.
+func (costSearch *pathCostSearch) explore(ctx context.Context, starts api.SolarSystemIdList,
+	maxJumps int, visit func(reached *reachedSystem) bool) {
+	startPaths := getStartSystems(costSearch.universe, &api.FromEntry{SolarSystems: starts})
+	fewestJumps := make(map[universe.Id]int)
+	isSettled := func(solarSystemId universe.Id, jumps int) bool {
+		known, visited := fewestJumps[solarSystemId]
+		return visited && ((maxJumps == unlimitedJumps) || (jumps >= known))
+	}
+	queue := &reachedSystemQueue{}
+
+	for index, start := range starts {
+		heap.Push(queue, &reachedSystem{solarSystemId: start, path: startPaths[index], costs: make([]float64, len(costSearch.rules))})
+	}
+	for queue.Len() > 0 {
+		if ctx.Err() != nil {
+			return
+		}
+		reached := heap.Pop(queue).(*reachedSystem)
+		if isSettled(reached.solarSystemId, reached.jumps) {
+			continue
+		}
+		_, visited := fewestJumps[reached.solarSystemId]
+		fewestJumps[reached.solarSystemId] = reached.jumps
+		if !visited && !visit(reached) {
+			return
+		}
+		if (maxJumps != unlimitedJumps) && (reached.jumps >= maxJumps) {
+			continue
+		}
+
+		for _, next := range costSearch.capability.NextPaths(reached.path) {
+			solarSystemId := next.Step().SolarSystemId()
+
+			if !isSettled(solarSystemId, reached.jumps+1) && !costSearch.avoid[solarSystemId] {
+				heap.Push(queue, &reachedSystem{
+					solarSystemId: solarSystemId,
+					path:          next,
+					costs:         costSearch.extendedCosts(reached.costs, next),
+					jumps:         reached.jumps + 1})
+			}
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dertseha/everoute/travel/search"
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+const (
+	maxRouteAlternatives      = 5
+	defaultAlternativeOverlap = 0.5
+)
+
+var alternativeRouteSearchLimits = routeSearchLimits{timeout: 10 * time.Second, idleTimeout: 2 * time.Second}
+
+type routeCandidate struct {
+	path    []api.PathEntry
+	costs   []api.RuleCost
+	summary *api.RouteSummary
+}
+
+// getFixedSystems returns the systems every route of the request has to pass.
+func getFixedSystems(request *api.RouteFindRequest, best []api.PathEntry) map[universe.Id]bool {
+	fixed := make(map[universe.Id]bool)
+
+	for _, solarSystemId := range request.Route.From.SolarSystems {
+		fixed[solarSystemId] = true
+	}
+	for _, waypoint := range request.Route.Via {
+		fixed[waypoint.SolarSystem] = true
+		for _, solarSystemId := range waypoint.SolarSystems {
+			fixed[solarSystemId] = true
+		}
+	}
+	fixed[best[len(best)-1].SolarSystem] = true
+
+	return fixed
+}
+
+func getIntermediateSystems(path []api.PathEntry, fixed map[universe.Id]bool) []universe.Id {
+	result := make([]universe.Id, 0, len(path))
+
+	for _, entry := range path {
+		if !fixed[entry.SolarSystem] {
+			result = append(result, entry.SolarSystem)
+		}
+	}
+
+	return result
+}
+
+func isSamePath(a, b []api.PathEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for index := range a {
+		if a[index].SolarSystem != b[index].SolarSystem {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pathOverlap returns the share of the intermediate systems of a, which are also part of b.
+func pathOverlap(a, b []api.PathEntry, fixed map[universe.Id]bool) float64 {
+	if isSamePath(a, b) {
+		return 1.0
+	}
+	systemsOfA := getIntermediateSystems(a, fixed)
+	if len(systemsOfA) == 0 {
+		return 0.0
+	}
+	systemsOfB := make(map[universe.Id]bool)
+	for _, entry := range b {
+		systemsOfB[entry.SolarSystem] = true
+	}
+	shared := 0
+	for _, solarSystemId := range systemsOfA {
+		if systemsOfB[solarSystemId] {
+			shared++
+		}
+	}
+
+	return float64(shared) / float64(len(systemsOfA))
+}
+
+// getAvoidanceWindows returns sets of consecutive systems of the best route. Avoiding one of
+// these sets forces a route to deviate from the best one for roughly the allowed overlap.
+func getAvoidanceWindows(intermediate []universe.Id, count uint, maxOverlap float64) [][]universe.Id {
+	windowSize := int(math.Ceil((1.0 - maxOverlap) * float64(len(intermediate))))
+	if windowSize < 1 {
+		windowSize = 1
+	}
+	if windowSize > len(intermediate) {
+		windowSize = len(intermediate)
+	}
+	lastOffset := len(intermediate) - windowSize
+	attempts := int(2*count + 1)
+	if attempts > lastOffset+1 {
+		attempts = lastOffset + 1
+	}
+
+	windows := make([][]universe.Id, 0, attempts)
+	for attempt := 0; attempt < attempts; attempt++ {
+		offset := 0
+		if attempts > 1 {
+			offset = attempt * lastOffset / (attempts - 1)
+		}
+		windows = append(windows, intermediate[offset:offset+windowSize])
+	}
+
+	return windows
+}
+
+func (service *RouteService) findAvoidingRoute(ctx context.Context, request *api.RouteFindRequest, avoid []universe.Id) *routeCandidate {
+	alternativeRequest := *request
+	avoidEntry := &api.AvoidEntry{SolarSystems: make(api.SolarSystemIdList, 0)}
+
+	if request.Route.Avoid != nil {
+		avoidEntry.SolarSystems = append(avoidEntry.SolarSystems, request.Route.Avoid.SolarSystems...)
+	}
+	avoidEntry.SolarSystems = append(avoidEntry.SolarSystems, avoid...)
+	alternativeRequest.Route.Avoid = avoidEntry
+	alternativeRequest.Alternatives = 0
+	alternativeRequest.OptimizeVia = ""
+
+	searcher, err := service.newRouteSearch(&alternativeRequest)
+	if err != nil {
+		return nil
+	}
+	var foundRoute *search.Route = nil
+	searcher.run(ctx, alternativeRouteSearchLimits, func(route *search.Route) { foundRoute = route })
+	if foundRoute == nil {
+		return nil
+	}
+
+	response := searcher.response(foundRoute)
+
+	return &routeCandidate{path: response.Path, costs: response.Costs, summary: response.Summary}
+}
+
+// findAlternatives searches for up to the requested number of routes that differ from the best one
+// and from each other. Candidates are found by avoiding parts of the best route and are then
+// picked in order of their rule costs, skipping those with too much overlap.
+func (service *RouteService) findAlternatives(ctx context.Context, request *api.RouteFindRequest, best []api.PathEntry) []api.RouteAlternative {
+	count := request.Alternatives
+	if count > maxRouteAlternatives {
+		count = maxRouteAlternatives
+	}
+	maxOverlap := defaultAlternativeOverlap
+	if request.AlternativeOverlap != nil {
+		maxOverlap = math.Max(0.0, math.Min(1.0, *request.AlternativeOverlap))
+	}
+	fixed := getFixedSystems(request, best)
+	intermediate := getIntermediateSystems(best, fixed)
+	if (count == 0) || (len(intermediate) == 0) {
+		return nil
+	}
+
+	windows := getAvoidanceWindows(intermediate, count, maxOverlap)
+	found := make([]*routeCandidate, len(windows))
+	limiter := make(chan bool, runtime.NumCPU())
+	var waitGroup sync.WaitGroup
+	for index, window := range windows {
+		waitGroup.Add(1)
+		go func(index int, window []universe.Id) {
+			defer waitGroup.Done()
+			limiter <- true
+			found[index] = service.findAvoidingRoute(ctx, request, window)
+			<-limiter
+		}(index, window)
+	}
+	waitGroup.Wait()
+
+	candidates := make([]*routeCandidate, 0, len(found))
+	for _, candidate := range found {
+		if (candidate != nil) && (len(candidate.path) > 0) {
+			candidates = append(candidates, candidate)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return compareRuleCosts(candidates[i].costs, candidates[j].costs) < 0 })
+
+	selected := [][]api.PathEntry{best}
+	alternatives := make([]api.RouteAlternative, 0, count)
+	for _, candidate := range candidates {
+		isDistinct := true
+		for _, other := range selected {
+			if (pathOverlap(candidate.path, other, fixed) > maxOverlap) || (pathOverlap(other, candidate.path, fixed) > maxOverlap) {
+				isDistinct = false
+			}
+		}
+		if isDistinct && (uint(len(alternatives)) < count) {
+			selected = append(selected, candidate.path)
+			alternatives = append(alternatives, api.RouteAlternative{Path: candidate.path, Costs: candidate.costs, Summary: candidate.summary})
+		}
+	}
+
+	return alternatives
+}
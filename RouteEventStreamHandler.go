@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dertseha/everoute/travel/search"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// RouteEventStreamHandler streams the progress of a route search as Server-Sent Events.
+// The request body is an api.RouteFindRequest. Every improved route is sent as "route" event
+// with an api.RouteFindResponse, the final "done" event carries the termination reason.
+// The search is stopped if the client disconnects.
+type RouteEventStreamHandler struct {
+	service *RouteService
+}
+
+func NewRouteEventStreamHandler(service *RouteService) *RouteEventStreamHandler {
+	handler := &RouteEventStreamHandler{
+		service: service}
+
+	return handler
+}
+
+func writeServerSentEvent(w http.ResponseWriter, flusher http.Flusher, event string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	flusher.Flush()
+
+	return nil
+}
+
+func (handler *RouteEventStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		writeServiceError(w, newServiceError(api.MethodNotAllowedErrorCode, "", "Method %s not allowed", r.Method))
+		return
+	}
+	flusher, canFlush := w.(http.Flusher)
+	if !canFlush {
+		writeServiceError(w, newServiceError(api.InternalErrorCode, "", "Streaming not supported"))
+		return
+	}
+	request := &api.RouteFindRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		writeServiceError(w, newServiceError(api.InvalidParameterErrorCode, "", "Invalid request body: %v", err))
+		return
+	}
+	searcher, err := handler.service.newRouteSearch(request)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ctx := r.Context()
+	improvements := 0
+	started := time.Now()
+	reason := searcher.run(ctx, defaultRouteSearchLimits, func(route *search.Route) {
+		improvements++
+		if ctx.Err() == nil {
+			writeServerSentEvent(w, flusher, api.RouteStreamRouteMessage, searcher.response(route))
+		}
+	})
+	if ctx.Err() == nil {
+		writeServerSentEvent(w, flusher, api.RouteStreamDoneMessage, &api.RouteStreamMessage{Type: api.RouteStreamDoneMessage, Reason: reason,
+			Status: searcher.status(reason, improvements > 0, time.Since(started), improvements)})
+	}
+}
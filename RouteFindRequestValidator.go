@@ -0,0 +1,309 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// routeFindRequestValidator checks a route request before any search is started.
+// It collects all problems of the request, each with the JSON path of the offending field.
+type routeFindRequestValidator struct {
+	universe universe.Universe
+	catalog  *SolarSystemCatalog
+	request  *api.RouteFindRequest
+	err      *ServiceError
+}
+
+// validateRouteFindRequest returns a ServiceError listing all problems of the request, or nil if it is valid.
+func validateRouteFindRequest(verse universe.Universe, catalog *SolarSystemCatalog, request *api.RouteFindRequest) error {
+	validator := &routeFindRequestValidator{universe: verse, catalog: catalog, request: request}
+
+	validator.validateCapabilities()
+	validator.validateRoute()
+	validator.validateRules()
+	validator.validateOptimizeVia()
+	if validator.err != nil {
+		return validator.err
+	}
+
+	return nil
+}
+
+func (validator *routeFindRequestValidator) problem(code string, field string, format string, args ...interface{}) {
+	if validator.err == nil {
+		validator.err = newServiceError(code, field, format, args...)
+	} else {
+		validator.err.add(code, field, format, args...)
+	}
+}
+
+func (validator *routeFindRequestValidator) validateSecurityLimit(limit *float64, field string) {
+	if (limit != nil) && ((*limit < -1.0) || (*limit > 1.0)) {
+		validator.problem(api.InvalidParameterErrorCode, field, "Security limit %v must be between -1.0 and 1.0", *limit)
+	}
+}
+
+func (validator *routeFindRequestValidator) validateSecurityLimits(limits *api.SecurityLimits, field string) {
+	if limits == nil {
+		return
+	}
+	validator.validateSecurityLimit(limits.Min, field+".min")
+	validator.validateSecurityLimit(limits.Max, field+".max")
+	if (limits.Min != nil) && (limits.Max != nil) && (*limits.Min > *limits.Max) {
+		validator.problem(api.InvalidParameterErrorCode, field, "Minimum security %v is above maximum security %v", *limits.Min, *limits.Max)
+	}
+}
+
+func (validator *routeFindRequestValidator) validateCapabilities() {
+	capabilities := &validator.request.Capabilities
+
+	if (capabilities.JumpGate == nil) && (capabilities.JumpDrive == nil) {
+		validator.problem(api.NoCapabilityErrorCode, "capabilities", "At least one travel capability is required")
+	}
+	if capabilities.JumpGate != nil {
+		validator.validateSecurityLimits(capabilities.JumpGate.Security, "capabilities.jumpGate.security")
+	}
+	if capabilities.JumpDrive != nil {
+		limit := capabilities.JumpDrive.DistanceLimit
+		if limit <= 0.0 {
+			validator.problem(api.InvalidParameterErrorCode, "capabilities.jumpDrive.distanceLimit",
+				"Distance limit %v must be greater than 0 light years", limit)
+		}
+		validator.validateSecurityLimits(capabilities.JumpDrive.Security, "capabilities.jumpDrive.security")
+	}
+}
+
+// canLeave determines whether the route can start in the solar system with the requested capabilities.
+// The jump drive can leave any system; Only its destinations are limited in security.
+func (validator *routeFindRequestValidator) canLeave(solarSystemId universe.Id) bool {
+	capabilities := &validator.request.Capabilities
+
+	if entry := validator.catalog.Entry(solarSystemId); (capabilities.JumpGate != nil) && (entry != nil) && (len(entry.Gates) > 0) {
+		return true
+	}
+
+	return capabilities.JumpDrive != nil
+}
+
+// canEnter determines whether the solar system can be travelled to with the requested capabilities.
+// Systems without stargates can only be reached by jump drive, which can not target high security space.
+func (validator *routeFindRequestValidator) canEnter(solarSystemId universe.Id) bool {
+	capabilities := &validator.request.Capabilities
+
+	if entry := validator.catalog.Entry(solarSystemId); (capabilities.JumpGate != nil) && (entry != nil) && (len(entry.Gates) > 0) {
+		return true
+	}
+	if capabilities.JumpDrive != nil {
+		maxSecurity := MaxJumpDriveSecurity
+		if capabilities.JumpDrive.Security != nil {
+			maxSecurity = getSecurityLimit(capabilities.JumpDrive.Security.Max, maxSecurity)
+		}
+		return displaySecurity(float64(validator.universe.SolarSystem(solarSystemId).TrueSecurity())) <= maxSecurity
+	}
+
+	return false
+}
+
+// validateSystem reports unknown systems; If the system needs to be travelled to or from, isTravelable
+// is given and systems for which it fails are reported as well. It returns whether the system is known.
+func (validator *routeFindRequestValidator) validateSystem(solarSystemId universe.Id, field string, isTravelable func(universe.Id) bool) bool {
+	if validator.universe.SolarSystem(solarSystemId) == nil {
+		validator.problem(api.UnknownSystemErrorCode, field, "Unknown solar system %v", solarSystemId)
+		return false
+	}
+	if (isTravelable != nil) && !isTravelable(solarSystemId) {
+		validator.problem(api.InvalidParameterErrorCode, field, "Solar system %v can not be reached with the requested capabilities", solarSystemId)
+	}
+
+	return true
+}
+
+func (validator *routeFindRequestValidator) validateGroup(regionId, constellationId universe.Id, field string) bool {
+	known := true
+
+	if (regionId != 0) && (validator.catalog.RegionName(regionId) == "") {
+		validator.problem(api.InvalidParameterErrorCode, field+".regionId", "Unknown region %v", regionId)
+		known = false
+	}
+	if (constellationId != 0) && !validator.catalog.HasConstellation(constellationId) {
+		validator.problem(api.InvalidParameterErrorCode, field+".constellationId", "Unknown constellation %v", constellationId)
+		known = false
+	}
+
+	return known
+}
+
+// validateTravelEntry checks a waypoint or destination and returns the solar systems it selects.
+// Reachability is only checked for single solar systems, as any system of a group may be taken.
+func (validator *routeFindRequestValidator) validateTravelEntry(entry *api.TravelEntry, field string, travelled bool) api.SolarSystemIdList {
+	if (entry.SolarSystem == 0) && !isSystemGroup(entry) {
+		validator.problem(api.InvalidParameterErrorCode, field, "A solar system, a list of solar systems, a region or a constellation is required")
+		return nil
+	}
+	if entry.SolarSystem != 0 {
+		var canEnter func(universe.Id) bool
+		if travelled && !isSystemGroup(entry) {
+			canEnter = validator.canEnter
+		}
+		validator.validateSystem(entry.SolarSystem, field+".solarSystem", canEnter)
+	}
+	for index, solarSystemId := range entry.SolarSystems {
+		validator.validateSystem(solarSystemId, fmt.Sprintf("%s.solarSystems[%d]", field, index), nil)
+	}
+	members := getTravelEntryMembers(validator.universe, validator.catalog, entry)
+	if validator.validateGroup(entry.RegionId, entry.ConstellationId, field) && (len(members) == 0) {
+		validator.problem(api.InvalidParameterErrorCode, field, "No solar system is selected")
+	}
+
+	return members
+}
+
+func (validator *routeFindRequestValidator) validateRoute() {
+	route := &validator.request.Route
+	from := &route.From
+	hasCapability := (validator.request.Capabilities.JumpGate != nil) || (validator.request.Capabilities.JumpDrive != nil)
+	travels := hasCapability && ((len(route.Via) > 0) || (route.To != nil) || (route.Nearest != nil))
+	starts := make(map[universe.Id]bool)
+	fixed := make(map[universe.Id]bool)
+
+	if (len(from.SolarSystems) == 0) && (from.RegionId == 0) && (from.ConstellationId == 0) {
+		validator.problem(api.InvalidParameterErrorCode, "route.from.solarSystems", "At least one start system is required")
+	}
+	var canLeave func(universe.Id) bool
+	if travels {
+		canLeave = validator.canLeave
+	}
+	for index, solarSystemId := range from.SolarSystems {
+		validator.validateSystem(solarSystemId, fmt.Sprintf("route.from.solarSystems[%d]", index), canLeave)
+		starts[solarSystemId] = true
+	}
+	fromMembers := getSystemGroupMembers(validator.universe, validator.catalog, from.SolarSystems, from.RegionId, from.ConstellationId)
+	if validator.validateGroup(from.RegionId, from.ConstellationId, "route.from") && (len(fromMembers) == 0) && (len(from.SolarSystems) == 0) {
+		validator.problem(api.InvalidParameterErrorCode, "route.from", "No start system is selected")
+	}
+	for _, solarSystemId := range fromMembers {
+		fixed[solarSystemId] = true
+	}
+	for index := range route.Via {
+		waypoint := &route.Via[index]
+		field := fmt.Sprintf("route.via[%d]", index)
+		for _, solarSystemId := range validator.validateTravelEntry(waypoint, field, hasCapability) {
+			fixed[solarSystemId] = true
+		}
+		if !isSystemGroup(waypoint) && starts[waypoint.SolarSystem] {
+			validator.problem(api.InvalidParameterErrorCode, field+".solarSystem", "Waypoint %v is a start system", waypoint.SolarSystem)
+		}
+	}
+	if route.To != nil {
+		for _, solarSystemId := range validator.validateTravelEntry(route.To, "route.to", hasCapability) {
+			fixed[solarSystemId] = true
+		}
+	}
+	if route.Nearest != nil {
+		validator.validateNearest(route.Nearest, route.To != nil)
+	}
+	if route.Avoid != nil {
+		for index, solarSystemId := range route.Avoid.SolarSystems {
+			field := fmt.Sprintf("route.avoid.solarSystems[%d]", index)
+			if validator.validateSystem(solarSystemId, field, nil) && fixed[solarSystemId] {
+				validator.problem(api.InvalidParameterErrorCode, field, "Solar system %v to avoid is part of the route", solarSystemId)
+			}
+		}
+	}
+}
+
+func (validator *routeFindRequestValidator) validateSecurityClass(class string, field string) {
+	if (class != "") && (class != api.HighSecClass) && (class != api.LowSecClass) && (class != api.NullSecClass) {
+		validator.problem(api.InvalidParameterErrorCode, field, "Unknown security class <%s>", class)
+	}
+}
+
+func (validator *routeFindRequestValidator) validateNearest(nearest *api.NearestEntry, hasDestination bool) {
+	if hasDestination {
+		validator.problem(api.InvalidParameterErrorCode, "route.nearest", "Either a destination or a nearest match can be requested")
+	}
+	if (nearest.SecurityClass == "") && (nearest.RegionId == 0) && (nearest.ConstellationId == 0) && (nearest.AdjacentSecurityClass == "") {
+		validator.problem(api.InvalidParameterErrorCode, "route.nearest", "At least one condition is required for the nearest match")
+	}
+	validator.validateSecurityClass(nearest.SecurityClass, "route.nearest.securityClass")
+	validator.validateSecurityClass(nearest.AdjacentSecurityClass, "route.nearest.adjacentSecurityClass")
+	validator.validateGroup(nearest.RegionId, nearest.ConstellationId, "route.nearest")
+}
+
+func (validator *routeFindRequestValidator) validateRules() {
+	rules := validator.request.Rules
+	if rules == nil {
+		return
+	}
+	priorities := make(map[uint]string)
+	checkPriority := func(name string, parameter *api.TravelRuleParameter) {
+		field := "rules." + name + ".priority"
+		if other, used := priorities[parameter.Priority]; used {
+			validator.problem(api.InvalidParameterErrorCode, field, "Priority %d is also used by rule <%s>", parameter.Priority, other)
+		} else {
+			priorities[parameter.Priority] = name
+		}
+	}
+
+	if rules.TransitCount != nil {
+		checkPriority("transitCount", &rules.TransitCount.TravelRuleParameter)
+	}
+	if rules.MinSecurity != nil {
+		checkPriority("minSecurity", &rules.MinSecurity.TravelRuleParameter)
+		validator.validateSecurityLimit(&rules.MinSecurity.Limit, "rules.minSecurity.limit")
+	}
+	if rules.MaxSecurity != nil {
+		checkPriority("maxSecurity", &rules.MaxSecurity.TravelRuleParameter)
+		validator.validateSecurityLimit(&rules.MaxSecurity.Limit, "rules.maxSecurity.limit")
+	}
+	if rules.JumpDistance != nil {
+		checkPriority("jumpDistance", &rules.JumpDistance.TravelRuleParameter)
+	}
+	if rules.WarpDistance != nil {
+		checkPriority("warpDistance", &rules.WarpDistance.TravelRuleParameter)
+	}
+}
+
+func (validator *routeFindRequestValidator) validateOptimizeVia() {
+	route := &validator.request.Route
+
+	if validator.request.OptimizeVia == "" {
+		return
+	}
+	if route.Nearest != nil {
+		validator.problem(api.InvalidParameterErrorCode, "route.nearest", "Waypoints can not be optimized towards a nearest match")
+	}
+	switch validator.request.OptimizeVia {
+	case api.OptimizeViaFixedDestination:
+		if route.To == nil {
+			validator.problem(api.InvalidParameterErrorCode, "route.to", "A destination is required to optimize the waypoints towards it")
+		}
+	case api.OptimizeViaReturnToStart:
+		if route.To != nil {
+			validator.problem(api.InvalidParameterErrorCode, "route.to", "A round trip has no separate destination")
+		}
+		if len(getSystemGroupMembers(validator.universe, validator.catalog, route.From.SolarSystems, route.From.RegionId, route.From.ConstellationId)) > 1 {
+			validator.problem(api.InvalidParameterErrorCode, "route.from.solarSystems", "A round trip requires a single start system")
+		}
+	case api.OptimizeViaOpenEnd:
+		if route.To != nil {
+			validator.problem(api.InvalidParameterErrorCode, "route.to", "An open end has no destination")
+		}
+	default:
+		validator.problem(api.InvalidParameterErrorCode, "optimizeVia", "Unknown waypoint optimization <%s>", validator.request.OptimizeVia)
+	}
+	for index := range route.Via {
+		if isSystemGroup(&route.Via[index]) {
+			validator.problem(api.InvalidParameterErrorCode, fmt.Sprintf("route.via[%d]", index), "Waypoints to optimize must be single solar systems")
+		}
+	}
+	if (route.To != nil) && isSystemGroup(route.To) {
+		validator.problem(api.InvalidParameterErrorCode, "route.to", "The destination of optimized waypoints must be a single solar system")
+	}
+	if len(route.Via) > maxOptimizedWaypoints {
+		validator.problem(api.InvalidParameterErrorCode, "route.via", "At most %d waypoints can be optimized", maxOptimizedWaypoints)
+	}
+}
@@ -0,0 +1,388 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dertseha/everoute/universe"
+	"google.golang.org/grpc/status"
+
+	"github.com/dertseha/everoute-web/api"
+	"github.com/dertseha/everoute-web/routepb"
+)
+
+// RouteGrpcServer provides the RouteService via gRPC, converting between the
+// protocol buffer messages and the api types.
+type RouteGrpcServer struct {
+	routepb.UnimplementedRouteServer
+	service *RouteService
+}
+
+func NewRouteGrpcServer(service *RouteService) *RouteGrpcServer {
+	server := &RouteGrpcServer{
+		service: service}
+
+	return server
+}
+
+// grpcError converts an error of the services to a gRPC status, with the error response as detail.
+func grpcError(err error) error {
+	serviceErr := serviceErrorOf(err)
+	response := serviceErr.response()
+	detail := &routepb.ErrorResponse{Error: response.Error, Code: response.Code}
+
+	for _, problem := range response.Problems {
+		detail.Problems = append(detail.Problems, &routepb.ErrorProblem{Code: problem.Code, Message: problem.Message, Field: problem.Field})
+	}
+	result := status.New(serviceErr.grpcCode(), serviceErr.Error())
+	if withDetails, detailErr := result.WithDetails(detail); detailErr == nil {
+		result = withDetails
+	}
+
+	return result.Err()
+}
+
+func (server *RouteGrpcServer) Find(ctx context.Context, request *routepb.RouteFindRequest) (*routepb.RouteFindResponse, error) {
+	httpRequest := (&http.Request{}).WithContext(ctx)
+	response := &api.RouteFindResponse{}
+
+	err := server.service.Find(httpRequest, routeFindRequestFromProto(request), response)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return routeFindResponseToProto(response), nil
+}
+
+func (server *RouteGrpcServer) Matrix(ctx context.Context, request *routepb.RouteMatrixRequest) (*routepb.RouteMatrixResponse, error) {
+	httpRequest := (&http.Request{}).WithContext(ctx)
+	response := &api.RouteMatrixResponse{}
+
+	err := server.service.Matrix(httpRequest, routeMatrixRequestFromProto(request), response)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return routeMatrixResponseToProto(response), nil
+}
+
+func (server *RouteGrpcServer) Reachable(ctx context.Context, request *routepb.RouteReachableRequest) (*routepb.RouteReachableResponse, error) {
+	httpRequest := (&http.Request{}).WithContext(ctx)
+	response := &api.RouteReachableResponse{}
+
+	err := server.service.Reachable(httpRequest, routeReachableRequestFromProto(request), response)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return routeReachableResponseToProto(response), nil
+}
+
+func solarSystemIdsFromProto(ids []int64) api.SolarSystemIdList {
+	result := make(api.SolarSystemIdList, 0, len(ids))
+
+	for _, id := range ids {
+		result = append(result, universe.Id(id))
+	}
+
+	return result
+}
+
+func solarSystemIdsToProto(ids api.SolarSystemIdList) []int64 {
+	result := make([]int64, 0, len(ids))
+
+	for _, id := range ids {
+		result = append(result, int64(id))
+	}
+
+	return result
+}
+
+func securityLimitsFromProto(limits *routepb.SecurityLimits) *api.SecurityLimits {
+	if limits == nil {
+		return nil
+	}
+	result := &api.SecurityLimits{}
+	if limits.Min != nil {
+		min := limits.GetMin()
+		result.Min = &min
+	}
+	if limits.Max != nil {
+		max := limits.GetMax()
+		result.Max = &max
+	}
+
+	return result
+}
+
+func travelRuleParameterFromProto(parameter *routepb.TravelRuleParameter) api.TravelRuleParameter {
+	return api.TravelRuleParameter{Priority: uint(parameter.GetPriority())}
+}
+
+func travelRulesetFromProto(rules *routepb.TravelRuleset) *api.TravelRuleset {
+	if rules == nil {
+		return nil
+	}
+	result := &api.TravelRuleset{}
+	if rules.TransitCount != nil {
+		result.TransitCount = &api.TransitCountTravelRuleParameter{TravelRuleParameter: travelRuleParameterFromProto(rules.TransitCount)}
+	}
+	if rules.MinSecurity != nil {
+		result.MinSecurity = &api.MinSecurityTravelRuleParameter{Limit: rules.MinSecurity.GetLimit()}
+		result.MinSecurity.Priority = uint(rules.MinSecurity.GetPriority())
+	}
+	if rules.MaxSecurity != nil {
+		result.MaxSecurity = &api.MaxSecurityTravelRuleParameter{Limit: rules.MaxSecurity.GetLimit()}
+		result.MaxSecurity.Priority = uint(rules.MaxSecurity.GetPriority())
+	}
+	if rules.JumpDistance != nil {
+		result.JumpDistance = &api.JumpDistanceTravelRuleParameter{TravelRuleParameter: travelRuleParameterFromProto(rules.JumpDistance)}
+	}
+	if rules.WarpDistance != nil {
+		result.WarpDistance = &api.WarpDistanceTravelRuleParameter{TravelRuleParameter: travelRuleParameterFromProto(rules.WarpDistance)}
+	}
+
+	return result
+}
+
+func travelEntryFromProto(entry *routepb.TravelEntry) *api.TravelEntry {
+	result := &api.TravelEntry{
+		SolarSystem:     universe.Id(entry.GetSolarSystem()),
+		RegionId:        universe.Id(entry.GetRegionId()),
+		ConstellationId: universe.Id(entry.GetConstellationId())}
+
+	if len(entry.GetSolarSystems()) > 0 {
+		result.SolarSystems = solarSystemIdsFromProto(entry.GetSolarSystems())
+	}
+
+	return result
+}
+
+func fromEntryFromProto(from *routepb.FromEntry) api.FromEntry {
+	return api.FromEntry{
+		SolarSystems:    solarSystemIdsFromProto(from.GetSolarSystems()),
+		RegionId:        universe.Id(from.GetRegionId()),
+		ConstellationId: universe.Id(from.GetConstellationId())}
+}
+
+func avoidEntryFromProto(avoid *routepb.AvoidEntry) *api.AvoidEntry {
+	if avoid == nil {
+		return nil
+	}
+
+	return &api.AvoidEntry{SolarSystems: solarSystemIdsFromProto(avoid.GetSolarSystems())}
+}
+
+func travelCapabilitiesFromProto(capabilities *routepb.TravelCapabilities) api.TravelCapabilities {
+	result := api.TravelCapabilities{}
+
+	if capabilities.GetJumpGate() != nil {
+		result.JumpGate = &api.JumpGateTravelCapability{
+			AvoidHighSec: capabilities.GetJumpGate().GetAvoidHighSec(),
+			Security:     securityLimitsFromProto(capabilities.GetJumpGate().GetSecurity())}
+	}
+	if capabilities.GetJumpDrive() != nil {
+		result.JumpDrive = &api.JumpDriveTravelCapability{
+			DistanceLimit: capabilities.GetJumpDrive().GetDistanceLimit(),
+			Security:      securityLimitsFromProto(capabilities.GetJumpDrive().GetSecurity())}
+	}
+
+	return result
+}
+
+func routeFindRequestFromProto(request *routepb.RouteFindRequest) *api.RouteFindRequest {
+	result := &api.RouteFindRequest{}
+	route := request.GetRoute()
+
+	result.Route.From = fromEntryFromProto(route.GetFrom())
+	for _, entry := range route.GetVia() {
+		result.Route.Via = append(result.Route.Via, *travelEntryFromProto(entry))
+	}
+	if route.GetTo() != nil {
+		result.Route.To = travelEntryFromProto(route.GetTo())
+	}
+	if nearest := route.GetNearest(); nearest != nil {
+		result.Route.Nearest = &api.NearestEntry{
+			SecurityClass:         nearest.GetSecurityClass(),
+			RegionId:              universe.Id(nearest.GetRegionId()),
+			ConstellationId:       universe.Id(nearest.GetConstellationId()),
+			AdjacentSecurityClass: nearest.GetAdjacentSecurityClass()}
+	}
+	result.Route.Avoid = avoidEntryFromProto(route.GetAvoid())
+	result.Capabilities = travelCapabilitiesFromProto(request.GetCapabilities())
+	result.Rules = travelRulesetFromProto(request.GetRules())
+	result.Alternatives = uint(request.GetAlternatives())
+	if request.AlternativeOverlap != nil {
+		overlap := request.GetAlternativeOverlap()
+		result.AlternativeOverlap = &overlap
+	}
+	result.Details = request.GetDetails()
+	result.OptimizeVia = request.GetOptimizeVia()
+
+	return result
+}
+
+func optionalDoubleToProto(value interface{}) *float64 {
+	if number, isNumber := value.(float64); isNumber {
+		return &number
+	}
+
+	return nil
+}
+
+func pathViaToProto(via *api.PathVia) *routepb.PathVia {
+	if via == nil {
+		return nil
+	}
+	result := &routepb.PathVia{Type: via.Type}
+	if via.Stargate != nil {
+		result.Stargate = &routepb.Stargate{Name: via.Stargate.Name, Position: positionToProto(&via.Stargate.Position)}
+	}
+
+	return result
+}
+
+func pathToProto(path []api.PathEntry) []*routepb.PathEntry {
+	result := make([]*routepb.PathEntry, 0, len(path))
+
+	for _, entry := range path {
+		result = append(result, &routepb.PathEntry{
+			SolarSystem:       int64(entry.SolarSystem),
+			JumpDistance:      optionalDoubleToProto(entry.JumpDistance),
+			WarpDistance:      optionalDoubleToProto(entry.WarpDistance),
+			Name:              entry.Name,
+			ConstellationId:   int64(entry.ConstellationId),
+			ConstellationName: entry.ConstellationName,
+			RegionId:          int64(entry.RegionId),
+			RegionName:        entry.RegionName,
+			Security:          optionalDoubleToProto(entry.Security),
+			TrueSecurity:      optionalDoubleToProto(entry.TrueSecurity),
+			SecurityClass:     entry.SecurityClass,
+			Via:               pathViaToProto(entry.Via),
+			Costs:             ruleCostsToProto(entry.Costs)})
+	}
+
+	return result
+}
+
+func ruleCostsToProto(costs []api.RuleCost) []*routepb.RuleCost {
+	result := make([]*routepb.RuleCost, 0, len(costs))
+
+	for _, cost := range costs {
+		result = append(result, &routepb.RuleCost{Rule: cost.Rule, Value: cost.Value})
+	}
+
+	return result
+}
+
+func routeSummaryToProto(summary *api.RouteSummary) *routepb.RouteSummary {
+	if summary == nil {
+		return nil
+	}
+	result := &routepb.RouteSummary{
+		Jumps:          make(map[string]uint32),
+		JumpDistance:   summary.JumpDistance,
+		WarpDistance:   summary.WarpDistance,
+		MinSecurity:    summary.MinSecurity,
+		MaxSecurity:    summary.MaxSecurity,
+		HighSecSystems: uint32(summary.HighSecSystems),
+		LowSecSystems:  uint32(summary.LowSecSystems),
+		NullSecSystems: uint32(summary.NullSecSystems)}
+
+	for jumpType, count := range summary.Jumps {
+		result.Jumps[jumpType] = uint32(count)
+	}
+	for _, region := range summary.Regions {
+		result.Regions = append(result.Regions, &routepb.RouteSummaryRegion{
+			RegionId:   int64(region.RegionId),
+			RegionName: region.RegionName})
+	}
+
+	return result
+}
+
+func routeFindResponseToProto(response *api.RouteFindResponse) *routepb.RouteFindResponse {
+	result := &routepb.RouteFindResponse{}
+
+	result.Path = pathToProto(response.Path)
+	result.Summary = routeSummaryToProto(response.Summary)
+	result.Costs = ruleCostsToProto(response.Costs)
+	result.WaypointOrder = solarSystemIdsToProto(response.WaypointOrder)
+	result.Start = int64(response.Start)
+	result.Destination = int64(response.Destination)
+	if response.Status != nil {
+		result.Status = &routepb.RouteSearchStatus{
+			State:        response.Status.State,
+			Reason:       response.Status.Reason,
+			Elapsed:      response.Status.Elapsed,
+			Optimal:      response.Status.Optimal,
+			Improvements: int32(response.Status.Improvements)}
+	}
+	for _, notice := range response.Notices {
+		result.Notices = append(result.Notices, &routepb.RouteNotice{
+			Type:         notice.Type,
+			Message:      notice.Message,
+			SolarSystems: solarSystemIdsToProto(notice.SolarSystems)})
+	}
+	for _, alternative := range response.Alternatives {
+		result.Alternatives = append(result.Alternatives, &routepb.RouteAlternative{
+			Path:    pathToProto(alternative.Path),
+			Costs:   ruleCostsToProto(alternative.Costs),
+			Summary: routeSummaryToProto(alternative.Summary)})
+	}
+
+	return result
+}
+
+func routeMatrixRequestFromProto(request *routepb.RouteMatrixRequest) *api.RouteMatrixRequest {
+	return &api.RouteMatrixRequest{
+		Origins:          solarSystemIdsFromProto(request.GetOrigins()),
+		Destinations:     solarSystemIdsFromProto(request.GetDestinations()),
+		Avoid:            avoidEntryFromProto(request.GetAvoid()),
+		Capabilities:     travelCapabilitiesFromProto(request.GetCapabilities()),
+		Rules:            travelRulesetFromProto(request.GetRules()),
+		IncludeDistances: request.GetIncludeDistances()}
+}
+
+func routeMatrixResponseToProto(response *api.RouteMatrixResponse) *routepb.RouteMatrixResponse {
+	result := &routepb.RouteMatrixResponse{}
+
+	for _, row := range response.Rows {
+		resultRow := &routepb.RouteMatrixRow{Origin: int64(row.Origin)}
+		for _, entry := range row.Entries {
+			resultRow.Entries = append(resultRow.Entries, &routepb.RouteMatrixEntry{
+				Destination:  int64(entry.Destination),
+				Reachable:    entry.Reachable,
+				Jumps:        int32(entry.Jumps),
+				JumpDistance: entry.JumpDistance,
+				WarpDistance: entry.WarpDistance})
+		}
+		result.Rows = append(result.Rows, resultRow)
+	}
+
+	return result
+}
+
+func routeReachableRequestFromProto(request *routepb.RouteReachableRequest) *api.RouteReachableRequest {
+	return &api.RouteReachableRequest{
+		From:         fromEntryFromProto(request.GetFrom()),
+		MaxJumps:     uint(request.GetMaxJumps()),
+		Avoid:        avoidEntryFromProto(request.GetAvoid()),
+		Capabilities: travelCapabilitiesFromProto(request.GetCapabilities()),
+		Rules:        travelRulesetFromProto(request.GetRules())}
+}
+
+func routeReachableResponseToProto(response *api.RouteReachableResponse) *routepb.RouteReachableResponse {
+	result := &routepb.RouteReachableResponse{}
+
+	for _, system := range response.Systems {
+		result.Systems = append(result.Systems, &routepb.ReachableSystem{
+			SolarSystem:  int64(system.SolarSystem),
+			Jumps:        int32(system.Jumps),
+			JumpDistance: system.JumpDistance,
+			WarpDistance: system.WarpDistance,
+			Costs:        ruleCostsToProto(system.Costs)})
+	}
+
+	return result
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/dertseha/everoute/travel/search"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+const maxRunningRouteJobs = 100
+
+// routeJob is a route search running in the background.
+type routeJob struct {
+	id       string
+	searcher *routeSearch
+	cancel   context.CancelFunc
+
+	mutex        sync.Mutex
+	started      time.Time
+	finished     time.Time
+	reason       string
+	improvements int
+	bestRoute    *search.Route
+	err          *ServiceError
+}
+
+func (job *routeJob) isDone() bool {
+	return !job.finished.IsZero()
+}
+
+// searchStatus describes how the search of a finished job ended. The caller must hold the lock
+// unless the job is known to be done.
+func (job *routeJob) searchStatus() *api.RouteSearchStatus {
+	return job.searcher.status(job.reason, job.bestRoute != nil, job.finished.Sub(job.started), job.improvements)
+}
+
+func (job *routeJob) status() *api.RouteStatusResponse {
+	job.mutex.Lock()
+	defer job.mutex.Unlock()
+
+	status := &api.RouteStatusResponse{
+		JobId:        job.id,
+		State:        api.RouteJobRunning,
+		Reason:       job.reason,
+		Improvements: job.improvements}
+	end := time.Now()
+	if job.isDone() {
+		status.State = api.RouteJobDone
+		end = job.finished
+	}
+	status.Elapsed = end.Sub(job.started).Seconds()
+	if job.err != nil {
+		status.Error = job.err.response()
+	}
+	if job.bestRoute != nil {
+		status.Route = job.searcher.response(job.bestRoute)
+		if job.isDone() && (job.err == nil) {
+			status.Route.Status = job.searchStatus()
+		}
+	}
+
+	return status
+}
+
+// failure returns the error which ended the job, or nil if there was none.
+func (job *routeJob) failure() *ServiceError {
+	job.mutex.Lock()
+	defer job.mutex.Unlock()
+
+	return job.err
+}
+
+// run performs the search of the job. A panic of the search ends the job with an internal error.
+func (job *routeJob) run(ctx context.Context, limits routeSearchLimits) {
+	var err error
+	var reason string
+
+	defer func() {
+		job.mutex.Lock()
+		job.reason = reason
+		if err != nil {
+			job.err = serviceErrorOf(err)
+		}
+		job.finished = time.Now()
+		job.mutex.Unlock()
+		job.cancel()
+	}()
+	defer recoverServiceError(&err, "search route")
+
+	reason = job.searcher.run(ctx, limits, func(route *search.Route) {
+		job.mutex.Lock()
+		job.bestRoute = route
+		job.improvements++
+		job.mutex.Unlock()
+	})
+}
+
+// RouteJobTable keeps route searches which run in the background, independent of any connection.
+// Jobs are limited to a maximum runtime and are removed once they are finished for longer than the expiry.
+type RouteJobTable struct {
+	maxRuntime time.Duration
+	expiry     time.Duration
+
+	mutex sync.Mutex
+	jobs  map[string]*routeJob
+}
+
+func NewRouteJobTable(maxRuntime, expiry time.Duration) *RouteJobTable {
+	table := &RouteJobTable{
+		maxRuntime: maxRuntime,
+		expiry:     expiry,
+		jobs:       make(map[string]*routeJob)}
+
+	return table
+}
+
+func newRouteJobId() string {
+	bytes := make([]byte, 16)
+	rand.Read(bytes)
+
+	return hex.EncodeToString(bytes)
+}
+
+// Start runs given search in the background and returns the ID of the new job.
+func (table *RouteJobTable) Start(searcher *routeSearch) (string, error) {
+	table.mutex.Lock()
+	defer table.mutex.Unlock()
+
+	table.dropExpired(time.Now())
+	running := 0
+	for _, job := range table.jobs {
+		job.mutex.Lock()
+		if !job.isDone() {
+			running++
+		}
+		job.mutex.Unlock()
+	}
+	if running >= maxRunningRouteJobs {
+		return "", newServiceError(api.BusyErrorCode, "", "Too many running jobs, try again later")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &routeJob{
+		id:       newRouteJobId(),
+		searcher: searcher,
+		cancel:   cancel,
+		started:  time.Now()}
+	table.jobs[job.id] = job
+	go job.run(ctx, routeSearchLimits{timeout: table.maxRuntime})
+
+	return job.id, nil
+}
+
+// Cancel stops the identified job. The job keeps the best route found until then.
+func (table *RouteJobTable) Cancel(id string) (*routeJob, error) {
+	job, err := table.Job(id)
+	if err != nil {
+		return nil, err
+	}
+	job.cancel()
+
+	return job, nil
+}
+
+// Job returns the identified job, or an error if it is unknown or expired.
+func (table *RouteJobTable) Job(id string) (*routeJob, error) {
+	table.mutex.Lock()
+	defer table.mutex.Unlock()
+
+	table.dropExpired(time.Now())
+	job, found := table.jobs[id]
+	if !found {
+		return nil, newServiceError(api.InvalidParameterErrorCode, "jobId", "Unknown job <%s>", id)
+	}
+
+	return job, nil
+}
+
+func (table *RouteJobTable) dropExpired(now time.Time) {
+	for id, job := range table.jobs {
+		job.mutex.Lock()
+		expired := job.isDone() && now.Sub(job.finished) > table.expiry
+		job.mutex.Unlock()
+
+		if expired {
+			job.cancel()
+			delete(table.jobs, id)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+const (
+	maxRouteMatrixSystems = 100
+	routeMatrixTimeout    = 25 * time.Second
+)
+
+func (service *RouteService) newMatrixCostSearch(request *api.RouteMatrixRequest) (costSearch *pathCostSearch, err error) {
+	defer func() {
+		if err != nil {
+			costSearch = nil
+		}
+	}()
+	defer recoverServiceError(&err, "calculate matrix")
+
+	for index, solarSystemId := range request.Origins {
+		if service.universe.SolarSystem(solarSystemId) == nil {
+			return nil, newServiceError(api.UnknownSystemErrorCode, fmt.Sprintf("origins[%d]", index),
+				"Unknown solar system %v", solarSystemId)
+		}
+	}
+	for index, solarSystemId := range request.Destinations {
+		if service.universe.SolarSystem(solarSystemId) == nil {
+			return nil, newServiceError(api.UnknownSystemErrorCode, fmt.Sprintf("destinations[%d]", index),
+				"Unknown solar system %v", solarSystemId)
+		}
+	}
+	jammerFilter := newCynoJammerFilter(service.cynoJammers.JammedSystems())
+	capability := getTravelCapability(service.universe, &request.Capabilities, jammerFilter)
+	costSearch = newPathCostSearch(service.universe, capability, request.Rules, request.Avoid)
+
+	return
+}
+
+func (service *RouteService) matrixRow(ctx context.Context, costSearch *pathCostSearch, origin universe.Id,
+	request *api.RouteMatrixRequest) api.RouteMatrixRow {
+	row := api.RouteMatrixRow{Origin: origin, Entries: make([]api.RouteMatrixEntry, len(request.Destinations))}
+	pending := make(map[universe.Id][]int)
+
+	for index, destination := range request.Destinations {
+		row.Entries[index] = api.RouteMatrixEntry{Destination: destination}
+		pending[destination] = append(pending[destination], index)
+	}
+
+	costSearch.explore(ctx, api.SolarSystemIdList{origin}, unlimitedJumps, func(reached *reachedSystem) bool {
+		indices, isDestination := pending[reached.solarSystemId]
+
+		if isDestination {
+			delete(pending, reached.solarSystemId)
+			entry := api.RouteMatrixEntry{Destination: reached.solarSystemId, Reachable: true, Jumps: reached.jumps}
+			if request.IncludeDistances {
+				jumpDistance, warpDistance := 0.0, 0.0
+				for _, step := range reached.path.Steps() {
+					pathEntry := pathEntryFromStep(step)
+					stepJumpDistance, _ := pathEntry.JumpDistance.(float64)
+					stepWarpDistance, _ := pathEntry.WarpDistance.(float64)
+					jumpDistance += stepJumpDistance
+					warpDistance += stepWarpDistance
+				}
+				entry.JumpDistance = &jumpDistance
+				entry.WarpDistance = &warpDistance
+			}
+			for _, index := range indices {
+				row.Entries[index] = entry
+			}
+		}
+
+		return len(pending) > 0
+	})
+
+	return row
+}
+
+// Matrix determines the routes between each of the origins and each of the destinations.
+// Instead of a separate search per pair, each origin is explored once for all destinations.
+func (service *RouteService) Matrix(r *http.Request, request *api.RouteMatrixRequest, response *api.RouteMatrixResponse) error {
+	if (len(request.Origins) > maxRouteMatrixSystems) || (len(request.Destinations) > maxRouteMatrixSystems) {
+		return newServiceError(api.InvalidParameterErrorCode, "", "At most %d origins and destinations are supported", maxRouteMatrixSystems)
+	}
+	costSearch, err := service.newMatrixCostSearch(request)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), routeMatrixTimeout)
+	defer cancel()
+	response.Rows = make([]api.RouteMatrixRow, len(request.Origins))
+	limiter := make(chan bool, runtime.NumCPU())
+	var waitGroup sync.WaitGroup
+	for index, origin := range request.Origins {
+		waitGroup.Add(1)
+		go func(index int, origin universe.Id) {
+			defer waitGroup.Done()
+			limiter <- true
+			response.Rows[index] = service.matrixRow(ctx, costSearch, origin, request)
+			<-limiter
+		}(index, origin)
+	}
+	waitGroup.Wait()
+	if ctx.Err() == context.DeadlineExceeded {
+		return newServiceError(api.TimeoutErrorCode, "", "Failed to calculate matrix within %v", routeMatrixTimeout)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+const (
+	maxReachableJumps     = 50
+	routeReachableTimeout = 25 * time.Second
+)
+
+func (service *RouteService) newReachableCostSearch(request *api.RouteReachableRequest) (costSearch *pathCostSearch, err error) {
+	defer func() {
+		if err != nil {
+			costSearch = nil
+		}
+	}()
+	defer recoverServiceError(&err, "determine reachable systems")
+
+	for index, solarSystemId := range request.From.SolarSystems {
+		if service.universe.SolarSystem(solarSystemId) == nil {
+			return nil, newServiceError(api.UnknownSystemErrorCode, fmt.Sprintf("from.solarSystems[%d]", index),
+				"Unknown solar system %v", solarSystemId)
+		}
+	}
+	jammerFilter := newCynoJammerFilter(service.cynoJammers.JammedSystems())
+	capability := getTravelCapability(service.universe, &request.Capabilities, jammerFilter)
+	if rules := request.Rules; (rules != nil) && ((rules.MinSecurity != nil) || (rules.MaxSecurity != nil)) {
+		minSecurity, maxSecurity := -1.0, 1.0
+		if rules.MinSecurity != nil {
+			minSecurity = rules.MinSecurity.Limit
+		}
+		if rules.MaxSecurity != nil {
+			maxSecurity = rules.MaxSecurity.Limit
+		}
+		capability = SecurityFilteringTravelCapability(service.universe, capability, minSecurity, maxSecurity)
+	}
+	costSearch = newPathCostSearch(service.universe, capability, request.Rules, request.Avoid)
+
+	return
+}
+
+// Reachable lists all systems which can be reached from the start systems within the given number of jumps.
+// In contrast to Find, the security limits of the rules are strict: Systems outside them are not entered.
+func (service *RouteService) Reachable(r *http.Request, request *api.RouteReachableRequest, response *api.RouteReachableResponse) error {
+	if request.MaxJumps > maxReachableJumps {
+		return newServiceError(api.InvalidParameterErrorCode, "maxJumps", "At most %d jumps are supported", maxReachableJumps)
+	}
+	costSearch, err := service.newReachableCostSearch(request)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), routeReachableTimeout)
+	defer cancel()
+	response.Systems = make([]api.ReachableSystem, 0)
+	costSearch.explore(ctx, request.From.SolarSystems, int(request.MaxJumps), func(reached *reachedSystem) bool {
+		system := api.ReachableSystem{
+			SolarSystem: reached.solarSystemId,
+			Jumps:       reached.jumps,
+			Costs:       costSearch.rules.ruleCosts(reached.costs)}
+
+		for _, step := range reached.path.Steps() {
+			pathEntry := pathEntryFromStep(step)
+			jumpDistance, _ := pathEntry.JumpDistance.(float64)
+			warpDistance, _ := pathEntry.WarpDistance.(float64)
+			system.JumpDistance += jumpDistance
+			system.WarpDistance += warpDistance
+		}
+		response.Systems = append(response.Systems, system)
+
+		return true
+	})
+	if ctx.Err() == context.DeadlineExceeded {
+		return newServiceError(api.TimeoutErrorCode, "", "Failed to determine reachable systems within %v", routeReachableTimeout)
+	}
+
+	return nil
+}
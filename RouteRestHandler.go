@@ -0,0 +1,252 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// RouteRestHandler provides the route search of RouteService as plain HTTP resource.
+//
+// GET requests describe the route with query parameters, solar systems can be given by name or ID:
+// from, via and avoid take comma separated lists, to a single system. jumpGate (default true),
+// avoidHighSec and jumpDrive (the distance limit in light years) select the capabilities.
+// minSecurity and maxSecurity add the respective rules with top priority, prefer lists further
+// rules (transitCount, jumpDistance, warpDistance) in order of priority.
+//
+// POST requests carry an api.RouteFindRequest as JSON body.
+type RouteRestHandler struct {
+	service *RouteService
+	catalog *SolarSystemCatalog
+}
+
+func NewRouteRestHandler(service *RouteService, catalog *SolarSystemCatalog) *RouteRestHandler {
+	handler := &RouteRestHandler{
+		service: service,
+		catalog: catalog}
+
+	return handler
+}
+
+func writeJsonResponse(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+// writeJsonError reports the error with given status. Errors without code are reported as internal errors.
+func writeJsonError(w http.ResponseWriter, status int, err error) {
+	writeJsonResponse(w, status, serviceErrorOf(err).response())
+}
+
+// writeServiceError reports the error with the status matching its code.
+func writeServiceError(w http.ResponseWriter, err error) {
+	serviceErr := serviceErrorOf(err)
+	writeJsonError(w, serviceErr.httpStatus(), serviceErr)
+}
+
+func (handler *RouteRestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var request *api.RouteFindRequest
+	var err error
+
+	switch r.Method {
+	case http.MethodGet:
+		request, err = handler.parseQuery(r.URL.Query())
+	case http.MethodPost:
+		request = &api.RouteFindRequest{}
+		if decodeErr := json.NewDecoder(r.Body).Decode(request); decodeErr != nil {
+			err = newServiceError(api.InvalidParameterErrorCode, "", "Invalid request body: %v", decodeErr)
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeServiceError(w, newServiceError(api.MethodNotAllowedErrorCode, "", "Method %s not allowed", r.Method))
+		return
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	response := &api.RouteFindResponse{}
+	err = handler.service.Find(r, request, response)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJsonResponse(w, http.StatusOK, response)
+}
+
+func (handler *RouteRestHandler) parseQuery(query url.Values) (request *api.RouteFindRequest, err error) {
+	request = &api.RouteFindRequest{}
+
+	if request.Route.From.SolarSystems, err = handler.parseSolarSystemList(query, "from"); err != nil {
+		return
+	}
+	if len(request.Route.From.SolarSystems) == 0 {
+		return nil, newServiceError(api.InvalidParameterErrorCode, "from", "Parameter <from> is required")
+	}
+	via, err := handler.parseSolarSystemList(query, "via")
+	if err != nil {
+		return
+	}
+	for _, solarSystemId := range via {
+		request.Route.Via = append(request.Route.Via, api.TravelEntry{SolarSystem: solarSystemId})
+	}
+	if query.Get("to") != "" {
+		var solarSystemId universe.Id
+		if solarSystemId, err = handler.resolveSolarSystem("to", query.Get("to")); err != nil {
+			return
+		}
+		request.Route.To = &api.TravelEntry{SolarSystem: solarSystemId}
+	}
+	avoid, err := handler.parseSolarSystemList(query, "avoid")
+	if err != nil {
+		return
+	}
+	if len(avoid) > 0 {
+		request.Route.Avoid = &api.AvoidEntry{SolarSystems: avoid}
+	}
+
+	if err = parseCapabilities(query, &request.Capabilities); err != nil {
+		return
+	}
+	if request.Rules, err = parseRules(query); err != nil {
+		return
+	}
+	if request.Details, err = parseBoolParameter(query, "details", false); err != nil {
+		return
+	}
+	request.OptimizeVia = query.Get("optimizeVia")
+
+	return
+}
+
+func (handler *RouteRestHandler) parseSolarSystemList(query url.Values, key string) (api.SolarSystemIdList, error) {
+	result := make(api.SolarSystemIdList, 0)
+
+	for _, value := range query[key] {
+		for _, token := range strings.Split(value, ",") {
+			if strings.TrimSpace(token) == "" {
+				continue
+			}
+			solarSystemId, err := handler.resolveSolarSystem(key, token)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, solarSystemId)
+		}
+	}
+
+	return result, nil
+}
+
+// resolveSolarSystem accepts either a numerical ID or the name of a solar system.
+func (handler *RouteRestHandler) resolveSolarSystem(key string, token string) (universe.Id, error) {
+	token = strings.TrimSpace(token)
+	if value, err := strconv.ParseInt(token, 10, 64); err == nil {
+		return universe.Id(value), nil
+	}
+	solarSystemId, found := handler.catalog.IdByName(token)
+	if !found {
+		return 0, newServiceError(api.UnknownSystemErrorCode, key, "Unknown solar system <%s>", token)
+	}
+
+	return solarSystemId, nil
+}
+
+func parseBoolParameter(query url.Values, key string, defaultValue bool) (bool, error) {
+	if query.Get(key) == "" {
+		return defaultValue, nil
+	}
+	value, err := strconv.ParseBool(query.Get(key))
+	if err != nil {
+		return false, newServiceError(api.InvalidParameterErrorCode, key, "Parameter <%s> must be a boolean", key)
+	}
+
+	return value, nil
+}
+
+func parseFloatParameter(query url.Values, key string) (*float64, error) {
+	if query.Get(key) == "" {
+		return nil, nil
+	}
+	value, err := strconv.ParseFloat(query.Get(key), 64)
+	if err != nil {
+		return nil, newServiceError(api.InvalidParameterErrorCode, key, "Parameter <%s> must be a number", key)
+	}
+
+	return &value, nil
+}
+
+func parseCapabilities(query url.Values, capabilities *api.TravelCapabilities) error {
+	useJumpGate, err := parseBoolParameter(query, "jumpGate", true)
+	if err != nil {
+		return err
+	}
+	avoidHighSec, err := parseBoolParameter(query, "avoidHighSec", false)
+	if err != nil {
+		return err
+	}
+	jumpDriveLimit, err := parseFloatParameter(query, "jumpDrive")
+	if err != nil {
+		return err
+	}
+
+	if useJumpGate {
+		capabilities.JumpGate = &api.JumpGateTravelCapability{AvoidHighSec: avoidHighSec}
+	}
+	if jumpDriveLimit != nil {
+		capabilities.JumpDrive = &api.JumpDriveTravelCapability{DistanceLimit: *jumpDriveLimit}
+	}
+
+	return nil
+}
+
+func parseRules(query url.Values) (*api.TravelRuleset, error) {
+	ruleset := &api.TravelRuleset{}
+	var priority uint
+
+	minSecurity, err := parseFloatParameter(query, "minSecurity")
+	if err != nil {
+		return nil, err
+	}
+	if minSecurity != nil {
+		ruleset.MinSecurity = &api.MinSecurityTravelRuleParameter{Limit: *minSecurity}
+		ruleset.MinSecurity.Priority = priority
+		priority++
+	}
+	maxSecurity, err := parseFloatParameter(query, "maxSecurity")
+	if err != nil {
+		return nil, err
+	}
+	if maxSecurity != nil {
+		ruleset.MaxSecurity = &api.MaxSecurityTravelRuleParameter{Limit: *maxSecurity}
+		ruleset.MaxSecurity.Priority = priority
+		priority++
+	}
+	for _, value := range query["prefer"] {
+		for _, name := range strings.Split(value, ",") {
+			parameter := api.TravelRuleParameter{Priority: priority}
+
+			switch strings.TrimSpace(name) {
+			case "transitCount":
+				ruleset.TransitCount = &api.TransitCountTravelRuleParameter{TravelRuleParameter: parameter}
+			case "jumpDistance":
+				ruleset.JumpDistance = &api.JumpDistanceTravelRuleParameter{TravelRuleParameter: parameter}
+			case "warpDistance":
+				ruleset.WarpDistance = &api.WarpDistanceTravelRuleParameter{TravelRuleParameter: parameter}
+			default:
+				return nil, newServiceError(api.InvalidParameterErrorCode, "prefer", "Unknown rule <%s> in parameter <prefer>", name)
+			}
+			priority++
+		}
+	}
+
+	return ruleset, nil
+}
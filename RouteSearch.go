@@ -0,0 +1,297 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dertseha/everoute/travel"
+	"github.com/dertseha/everoute/travel/capabilities/jumpgate"
+	"github.com/dertseha/everoute/travel/rules/jumpdistance"
+	"github.com/dertseha/everoute/travel/rules/warpdistance"
+	"github.com/dertseha/everoute/travel/search"
+	"github.com/dertseha/everoute/universe"
+	"github.com/dertseha/everoute/util"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// routeSearchLimits determine how long a search may run. The idle timeout stops a search
+// if no better route was found within that time; It is not applied if zero.
+type routeSearchLimits struct {
+	timeout     time.Duration
+	idleTimeout time.Duration
+}
+
+var defaultRouteSearchLimits = routeSearchLimits{timeout: 25 * time.Second, idleTimeout: 2 * time.Second}
+
+type routeSearchResultCollector struct {
+	channel chan *search.Route
+}
+
+func (collector *routeSearchResultCollector) Collect(route *search.Route) {
+	collector.channel <- route
+}
+
+// routeSearch is the lifecycle of a single search for a route request.
+// It is shared by all interfaces which provide route searches.
+type routeSearch struct {
+	universe     universe.Universe
+	catalog      *SolarSystemCatalog
+	request      *api.RouteFindRequest
+	jammerFilter *cynoJammerFilter
+	capability   travel.TravelCapability
+	rule         travel.TravelRule
+	starts       []travel.Path
+	waypoints    []search.SearchCriterion
+	destination  search.SearchCriterion
+	notices      []api.RouteNotice
+}
+
+func (service *RouteService) newRouteSearch(request *api.RouteFindRequest) (searcher *routeSearch, err error) {
+	defer func() {
+		if err != nil {
+			searcher = nil
+		}
+	}()
+	defer recoverServiceError(&err, "find route")
+
+	if err = validateRouteFindRequest(service.universe, service.catalog, request); err != nil {
+		return
+	}
+	request = service.resolveSystemGroups(request)
+	request, notices := clampJumpDriveDistanceLimit(request)
+	if request.OptimizeVia != "" {
+		request = withWaypointOrderEnd(request)
+	}
+	jammerFilter := newCynoJammerFilter(service.cynoJammers.JammedSystems())
+	searcher = &routeSearch{
+		universe:     service.universe,
+		catalog:      service.catalog,
+		request:      request,
+		jammerFilter: jammerFilter,
+		capability:   getTravelCapability(service.universe, &request.Capabilities, jammerFilter),
+		rule:         getTravelRule(request.Rules),
+		starts:       getStartSystems(service.universe, &request.Route.From),
+		notices:      notices}
+	searcher.createCriteria()
+
+	return
+}
+
+// clampJumpDriveDistanceLimit limits the jump drive to the longest range the universe is prepared for.
+// If the requested limit is longer, the request is copied and a notice describes the change.
+func clampJumpDriveDistanceLimit(request *api.RouteFindRequest) (*api.RouteFindRequest, []api.RouteNotice) {
+	jumpDrive := request.Capabilities.JumpDrive
+
+	if (jumpDrive == nil) || (jumpDrive.DistanceLimit <= MaxJumpDriveRange) {
+		return request, nil
+	}
+	clamped := *request
+	clampedJumpDrive := *jumpDrive
+	clampedJumpDrive.DistanceLimit = MaxJumpDriveRange
+	clamped.Capabilities.JumpDrive = &clampedJumpDrive
+	notice := api.RouteNotice{
+		Type:    api.DistanceLimitClampedNoticeType,
+		Message: fmt.Sprintf("Jump drive distance limit %v reduced to the maximum of %v light years", jumpDrive.DistanceLimit, MaxJumpDriveRange)}
+
+	return &clamped, []api.RouteNotice{notice}
+}
+
+// createCriteria prepares the search criteria for the waypoints and the destination.
+// It is called while creating the search, so that any failure is reported as error of the request.
+func (searcher *routeSearch) createCriteria() {
+	route := &searcher.request.Route
+
+	for index := range route.Via {
+		searcher.waypoints = append(searcher.waypoints, getTravelEntrySearchCriterion(searcher.universe, &route.Via[index], searcher.rule, route.Avoid))
+	}
+	if route.To != nil {
+		searcher.destination = getTravelEntrySearchCriterion(searcher.universe, route.To, searcher.rule, route.Avoid)
+	} else if route.Nearest != nil {
+		isMatch := getNearestMatcher(searcher.universe, searcher.catalog, route.Nearest, route.From.SolarSystems)
+		searcher.destination = getOptimizedSearchCriterion(MatchingSystemSearchCriterion(isMatch), searcher.rule, route.Avoid)
+	}
+}
+
+// run performs the search and reports every improved route to onRoute, which is always
+// called from the calling goroutine. The search ends when the finder is done, the limits
+// are reached, or the context is cancelled. An optimization of the waypoint order is part of the search.
+// The returned reason is one of the api.SearchReason* termination constants.
+func (searcher *routeSearch) run(ctx context.Context, limits routeSearchLimits, onRoute func(route *search.Route)) string {
+	started := time.Now()
+	if searcher.request.OptimizeVia != "" {
+		searcher.optimizeWaypointOrder(ctx, limits)
+	}
+	searchDone := make(chan int)
+	routeChannel := make(chan *search.Route)
+	collector := &routeSearchResultCollector{channel: routeChannel}
+
+	builder := search.NewRouteFinder(searcher.capability, searcher.rule, searcher.starts, collector, func() { close(searchDone) })
+	for _, waypoint := range searcher.waypoints {
+		builder.AddWaypoint(waypoint)
+	}
+	if searcher.destination != nil {
+		builder.ForDestination(searcher.destination)
+	}
+
+	finder := builder.Build()
+	timeout := time.After(limits.timeout - time.Since(started))
+	var idleTimeout <-chan time.Time
+
+	stop := func(reason string) string {
+		finder.Stop()
+		for {
+			select {
+			case route := <-routeChannel:
+				onRoute(route)
+			case <-searchDone:
+				return reason
+			}
+		}
+	}
+
+	for {
+		select {
+		case route := <-routeChannel:
+			onRoute(route)
+			if limits.idleTimeout > 0 {
+				idleTimeout = time.After(limits.idleTimeout)
+			}
+		case <-searchDone:
+			return api.SearchReasonCompleted
+		case <-timeout:
+			return stop(api.SearchReasonTimedOut)
+		case <-idleTimeout:
+			return stop(api.SearchReasonIdle)
+		case <-ctx.Done():
+			return stop(api.SearchReasonCancelled)
+		}
+	}
+}
+
+// newRouteSearchStatus describes how a search ended, based on the reason returned by run.
+// Only a search which completed with a route has proven the route to be optimal.
+func newRouteSearchStatus(reason string, routeFound bool, elapsed time.Duration, improvements int) *api.RouteSearchStatus {
+	status := &api.RouteSearchStatus{
+		Reason:       reason,
+		Elapsed:      elapsed.Seconds(),
+		Improvements: improvements}
+
+	switch reason {
+	case api.SearchReasonCompleted:
+		if routeFound {
+			status.State = api.RouteSearchComplete
+			status.Optimal = true
+		} else {
+			status.State = api.RouteSearchNoRoute
+		}
+	case api.SearchReasonCancelled:
+		status.State = api.RouteSearchCancelled
+	default:
+		status.State = api.RouteSearchTimedOut
+	}
+
+	return status
+}
+
+// status describes how the search ended, like newRouteSearchStatus. If the waypoint order could not
+// be optimized, the route is not optimal for the request, even if the search completed.
+func (searcher *routeSearch) status(reason string, routeFound bool, elapsed time.Duration, improvements int) *api.RouteSearchStatus {
+	status := newRouteSearchStatus(reason, routeFound, elapsed, improvements)
+
+	for _, notice := range searcher.notices {
+		if notice.Type == api.WaypointOrderKeptNoticeType {
+			status.Optimal = false
+		}
+	}
+
+	return status
+}
+
+func pathEntryFromStep(step *travel.Step) api.PathEntry {
+	jumpDistance := step.EnterCosts().Cost(jumpdistance.NullCost()).Value()
+	warpDistance := step.EnterCosts().Cost(warpdistance.NullCost()).Join(step.ContinueCosts().Cost(warpdistance.NullCost())).Value()
+	pathEntry := api.PathEntry{SolarSystem: step.SolarSystemId()}
+
+	if jumpDistance > 0.0 {
+		pathEntry.JumpDistance = jumpDistance
+	}
+	if warpDistance > 0.0 {
+		pathEntry.WarpDistance = warpDistance / util.MetersPerAu
+	}
+
+	return pathEntry
+}
+
+// pathVia describes how the step was entered from the previous solar system.
+// For jump gates, the stargate in the previous solar system is added if known.
+func (searcher *routeSearch) pathVia(previousSolarSystemId universe.Id, step *travel.Step) *api.PathVia {
+	via := &api.PathVia{Type: step.JumpType()}
+
+	if via.Type == jumpgate.JumpType {
+		if gate := searcher.catalog.Gate(previousSolarSystemId, step.SolarSystemId()); gate != nil {
+			via.Stargate = &api.Stargate{Name: gate.Name, Position: api.Position{X: gate.X, Y: gate.Y, Z: gate.Z}}
+		}
+	}
+
+	return via
+}
+
+// describePathEntry adds the descriptive details of the solar system to given entry.
+func (searcher *routeSearch) describePathEntry(entry *api.PathEntry) {
+	trueSec := float64(searcher.universe.SolarSystem(entry.SolarSystem).TrueSecurity())
+	security := displaySecurity(trueSec)
+
+	if catalogEntry := searcher.catalog.Entry(entry.SolarSystem); catalogEntry != nil {
+		entry.Name = catalogEntry.Name
+		entry.ConstellationId = catalogEntry.ConstellationId
+		entry.ConstellationName = searcher.catalog.ConstellationName(catalogEntry.ConstellationId)
+		entry.RegionId = catalogEntry.RegionId
+		entry.RegionName = searcher.catalog.RegionName(catalogEntry.RegionId)
+	}
+	entry.Security = security
+	entry.TrueSecurity = trueSec
+	entry.SecurityClass = securityClass(security)
+}
+
+// response creates the response for given route, which may be nil if none was found.
+func (searcher *routeSearch) response(foundRoute *search.Route) *api.RouteFindResponse {
+	response := &api.RouteFindResponse{}
+
+	response.Path = make([]api.PathEntry, 0)
+	if foundRoute != nil {
+		rules := getPriorizedTravelRules(searcher.request.Rules)
+		for index, step := range foundRoute.Steps() {
+			pathEntry := pathEntryFromStep(step)
+			if (index > 0) && (step.JumpType() != "") {
+				pathEntry.Via = searcher.pathVia(response.Path[index-1].SolarSystem, step)
+			}
+			pathEntry.Costs = rules.ruleCosts(rules.stepCosts(step))
+			response.Path = append(response.Path, pathEntry)
+		}
+		response.Costs = rules.totalCosts(response.Path)
+	}
+	if searcher.request.Details {
+		for index := range response.Path {
+			searcher.describePathEntry(&response.Path[index])
+		}
+	}
+	response.Summary = searcher.summary(response.Path)
+	if len(response.Path) > 0 {
+		response.Start = response.Path[0].SolarSystem
+		if (searcher.request.Route.To != nil) || (searcher.request.Route.Nearest != nil) {
+			response.Destination = response.Path[len(response.Path)-1].SolarSystem
+		}
+	}
+	if searcher.request.OptimizeVia != "" {
+		response.WaypointOrder = make(api.SolarSystemIdList, 0, len(searcher.request.Route.Via))
+		for _, waypoint := range searcher.request.Route.Via {
+			response.WaypointOrder = append(response.WaypointOrder, waypoint.SolarSystem)
+		}
+	}
+	response.Notices = append(append(response.Notices, searcher.notices...),
+		getCynoJammerNotices(searcher.universe, searcher.jammerFilter, &searcher.request.Capabilities, response.Path)...)
+
+	return response
+}
@@ -1,11 +1,7 @@
 package main
 
 import (
-	"errors"
-	"fmt"
-	"log"
 	"net/http"
-	"sort"
 	"time"
 
 	"github.com/dertseha/everoute/travel"
@@ -13,187 +9,210 @@ import (
 	"github.com/dertseha/everoute/travel/capabilities/jumpdrive"
 	"github.com/dertseha/everoute/travel/capabilities/jumpgate"
 	"github.com/dertseha/everoute/travel/rules"
-	"github.com/dertseha/everoute/travel/rules/jumpdistance"
-	"github.com/dertseha/everoute/travel/rules/security"
-	"github.com/dertseha/everoute/travel/rules/transitcount"
-	"github.com/dertseha/everoute/travel/rules/warpdistance"
 	"github.com/dertseha/everoute/travel/search"
 	"github.com/dertseha/everoute/universe"
-	"github.com/dertseha/everoute/util"
 
 	"github.com/dertseha/everoute-web/api"
 )
 
-type routeSearchResultCollector struct {
-	channel chan *search.Route
-}
-
-func (collector *routeSearchResultCollector) Collect(route *search.Route) {
-	collector.channel <- route
-}
-
 type RouteService struct {
-	universe universe.Universe
+	universe    universe.Universe
+	catalog     *SolarSystemCatalog
+	cynoJammers *CynoJammerList
+	jobs        *RouteJobTable
 }
 
-func NewRouteService(universe universe.Universe) *RouteService {
+func NewRouteService(universe universe.Universe, catalog *SolarSystemCatalog, cynoJammers *CynoJammerList, jobs *RouteJobTable) *RouteService {
 	service := &RouteService{
-		universe: universe}
+		universe:    universe,
+		catalog:     catalog,
+		cynoJammers: cynoJammers,
+		jobs:        jobs}
 
 	return service
 }
 
-func (service *RouteService) Find(r *http.Request, request *api.RouteFindRequest, response *api.RouteFindResponse) (err error) {
-	defer func() {
-		if panic := recover(); panic != nil {
-			errorText := fmt.Sprintf("Failed to find route: \"%s\"", panic)
-			log.Print(errorText)
-			err = errors.New(errorText)
-		}
-	}()
-
-	capability := getTravelCapability(service.universe, &request.Capabilities)
-	rule := getTravelRule(request.Rules)
-	starts := getStartSystems(service.universe, &request.Route.From)
-	timeout := time.After(25 * time.Second)
-	searchDone := make(chan int)
-	routeChannel := make(chan *search.Route)
-	collector := &routeSearchResultCollector{channel: routeChannel}
-	done := false
-	var foundRoute *search.Route = nil
-
-	builder := search.NewRouteFinder(capability, rule, starts, collector, func() { searchDone <- 1; close(searchDone) })
-	for _, waypoint := range request.Route.Via {
-		builder.AddWaypoint(getOptimizedSystemSearchCriterion(service.universe, waypoint.SolarSystem, rule, request.Route.Avoid))
-	}
-	if request.Route.To != nil {
-		builder.ForDestination(getOptimizedSystemSearchCriterion(service.universe, request.Route.To.SolarSystem, rule, request.Route.Avoid))
-	}
-
-	finder := builder.Build()
-	onTimeout := func() {
-		finder.Stop()
-		<-searchDone
-		done = true
+func (service *RouteService) Find(r *http.Request, request *api.RouteFindRequest, response *api.RouteFindResponse) error {
+	searcher, err := service.newRouteSearch(request)
+	if err != nil {
+		return err
 	}
 
-	select {
-	case route := <-routeChannel:
+	var foundRoute *search.Route = nil
+	improvements := 0
+	started := time.Now()
+	reason := searcher.run(r.Context(), defaultRouteSearchLimits, func(route *search.Route) {
 		foundRoute = route
-	case <-searchDone:
-		done = true
-	case <-timeout:
-		onTimeout()
-	}
-	for !done {
-		select {
-		case route := <-routeChannel:
-			foundRoute = route
-		case <-searchDone:
-			done = true
-		case <-timeout:
-			onTimeout()
-		case <-time.After(2 * time.Second):
-			onTimeout()
-		}
+		improvements++
+	})
+	*response = *searcher.response(foundRoute)
+	response.Status = searcher.status(reason, foundRoute != nil, time.Since(started), improvements)
+	if (request.Alternatives > 0) && (len(response.Path) > 2) {
+		response.Alternatives = service.findAlternatives(r.Context(), searcher.request, response.Path)
 	}
 
-	response.Path = make([]api.PathEntry, 0)
-	if foundRoute != nil {
-		steps := foundRoute.Steps()
-		for _, step := range steps {
-			jumpDistance := step.EnterCosts().Cost(jumpdistance.NullCost()).Value()
-			warpDistance := step.EnterCosts().Cost(warpdistance.NullCost()).Join(step.ContinueCosts().Cost(warpdistance.NullCost())).Value()
-			pathEntry := api.PathEntry{SolarSystem: step.SolarSystemId()}
-
-			if jumpDistance > 0.0 {
-				pathEntry.JumpDistance = jumpDistance
-			}
-			if warpDistance > 0.0 {
-				pathEntry.WarpDistance = warpDistance / util.MetersPerAu
-			}
-			response.Path = append(response.Path, pathEntry)
-		}
+	return nil
+}
+
+// Submit starts a route search in the background, which can be queried with Status and Result.
+func (service *RouteService) Submit(r *http.Request, request *api.RouteFindRequest, response *api.RouteSubmitResponse) error {
+	searcher, err := service.newRouteSearch(request)
+	if err != nil {
+		return err
 	}
+	response.JobId, err = service.jobs.Start(searcher)
 
-	return
+	return err
 }
 
-type priorizedTravelRule struct {
-	priority uint
-	rule     travel.TravelRule
+// Status reports the progress of a submitted search, including the best route found so far.
+func (service *RouteService) Status(r *http.Request, request *api.RouteJobRequest, response *api.RouteStatusResponse) error {
+	job, err := service.jobs.Job(request.JobId)
+	if err != nil {
+		return err
+	}
+	*response = *job.status()
+
+	return nil
 }
 
-type priorizedTravelRules []*priorizedTravelRule
+// Cancel stops a submitted search and reports its status. The search ends shortly after.
+func (service *RouteService) Cancel(r *http.Request, request *api.RouteJobRequest, response *api.RouteStatusResponse) error {
+	job, err := service.jobs.Cancel(request.JobId)
+	if err != nil {
+		return err
+	}
+	*response = *job.status()
 
-func (rules priorizedTravelRules) Len() int {
-	return len(rules)
+	return nil
 }
 
-func (rules priorizedTravelRules) Swap(i, j int) {
-	rules[i], rules[j] = rules[j], rules[i]
-}
+// Result returns the route of a finished search.
+func (service *RouteService) Result(r *http.Request, request *api.RouteJobRequest, response *api.RouteFindResponse) error {
+	job, err := service.jobs.Job(request.JobId)
+	if err != nil {
+		return err
+	}
+	status := job.status()
+	if failure := job.failure(); failure != nil {
+		return failure
+	}
+	if status.State != api.RouteJobDone {
+		return newServiceError(api.InvalidParameterErrorCode, "jobId", "Job <%s> is still running", request.JobId)
+	}
+	if status.Route != nil {
+		*response = *status.Route
+	} else {
+		*response = *job.searcher.response(nil)
+		response.Status = job.searchStatus()
+	}
 
-func (rules priorizedTravelRules) Less(i, j int) bool {
-	return rules[i].priority < rules[j].priority
+	return nil
 }
 
-func getTravelRule(ruleset *api.TravelRuleset) travel.TravelRule {
-	list := make([]travel.TravelRule, 0)
-	hasTransitCount := false
-	priorizedRules := make(priorizedTravelRules, 0)
+// jumpDriveReaches returns whether given jump drive capability can jump from one system to the other.
+func jumpDriveReaches(verse universe.Universe, jumpDrive travel.TravelCapability, from, to universe.Id) bool {
+	start := getStartSystems(verse, &api.FromEntry{SolarSystems: api.SolarSystemIdList{from}})[0]
 
-	addRule := func(priority uint, rule travel.TravelRule) {
-		entry := &priorizedTravelRule{priority: priority, rule: rule}
-		priorizedRules = append(priorizedRules, entry)
+	for _, path := range jumpDrive.NextPaths(start) {
+		if path.Step().SolarSystemId() == to {
+			return true
+		}
 	}
 
-	if ruleset != nil {
-		if ruleset.TransitCount != nil {
-			addRule(ruleset.TransitCount.Priority, transitcount.Rule())
-			hasTransitCount = true
-		}
-		if ruleset.MinSecurity != nil {
-			addRule(ruleset.MinSecurity.Priority, security.MinRule(ruleset.MinSecurity.Limit))
-		}
-		if ruleset.MaxSecurity != nil {
-			addRule(ruleset.MaxSecurity.Priority, security.MaxRule(ruleset.MaxSecurity.Limit))
-		}
-		if ruleset.JumpDistance != nil {
-			addRule(ruleset.JumpDistance.Priority, jumpdistance.Rule())
+	return false
+}
+
+// getCynoJammerNotices reports the jammed systems which the path enters through jump gates,
+// although the jump drive could have targeted them from the previous system if they were not jammed.
+func getCynoJammerNotices(verse universe.Universe, filter *cynoJammerFilter, requestedCapabilities *api.TravelCapabilities, path []api.PathEntry) []api.RouteNotice {
+	var notices []api.RouteNotice
+
+	if requestedCapabilities.JumpDrive == nil {
+		return notices
+	}
+	jumpDrive := getJumpDriveCapability(verse, requestedCapabilities.JumpDrive)
+	jammedOnPath := make(api.SolarSystemIdList, 0)
+	for index := 1; index < len(path); index++ {
+		entry := &path[index]
+		if (entry.Via == nil) || (entry.Via.Type != jumpgate.JumpType) || !filter.jammedSystems[entry.SolarSystem] {
+			continue
 		}
-		if ruleset.WarpDistance != nil {
-			addRule(ruleset.WarpDistance.Priority, warpdistance.Rule())
+		if jumpDriveReaches(verse, jumpDrive, path[index-1].SolarSystem, entry.SolarSystem) {
+			jammedOnPath = append(jammedOnPath, entry.SolarSystem)
 		}
 	}
-	sort.Sort(priorizedRules)
-	for _, entry := range priorizedRules {
-		list = append(list, entry.rule)
+	if len(jammedOnPath) > 0 {
+		notices = append(notices, api.RouteNotice{
+			Type:         api.CynoJammedNoticeType,
+			Message:      "Route enters cyno jammed solar systems through jump gates",
+			SolarSystems: jammedOnPath})
 	}
-	if !hasTransitCount {
-		list = append(list, transitcount.Rule())
+
+	return notices
+}
+
+func getTravelRule(ruleset *api.TravelRuleset) travel.TravelRule {
+	list := make([]travel.TravelRule, 0)
+
+	for _, entry := range getPriorizedTravelRules(ruleset) {
+		list = append(list, entry.rule)
 	}
 
 	return rules.TravelRuleset(list...)
 }
 
-func getTravelCapability(universe universe.Universe, requestedCapabilities *api.TravelCapabilities) travel.TravelCapability {
+func getTravelCapability(universe universe.Universe, requestedCapabilities *api.TravelCapabilities, jammerFilter *cynoJammerFilter) travel.TravelCapability {
 	list := make([]travel.TravelCapability, 0)
 
 	if requestedCapabilities.JumpGate != nil {
-		list = append(list, jumpgate.JumpGateTravelCapability(universe, requestedCapabilities.JumpGate.AvoidHighSec))
+		capability := jumpgate.JumpGateTravelCapability(universe, requestedCapabilities.JumpGate.AvoidHighSec)
+		limits := requestedCapabilities.JumpGate.Security
+
+		if limits != nil {
+			capability = SecurityFilteringTravelCapability(universe, capability,
+				getSecurityLimit(limits.Min, -1.0), getSecurityLimit(limits.Max, 1.0))
+		}
+		list = append(list, capability)
 	}
 	if requestedCapabilities.JumpDrive != nil {
-		list = append(list, jumpdrive.JumpDriveTravelCapability(universe, requestedCapabilities.JumpDrive.DistanceLimit))
+		list = append(list, FilteringTravelCapability(getJumpDriveCapability(universe, requestedCapabilities.JumpDrive), jammerFilter.isAllowed))
 	}
 
 	return capabilities.CombiningTravelCapability(list...)
 }
 
+// getJumpDriveCapability returns the jump drive capability within the requested security limits,
+// without regard to cyno jammers.
+func getJumpDriveCapability(universe universe.Universe, jumpDrive *api.JumpDriveTravelCapability) travel.TravelCapability {
+	capability := jumpdrive.JumpDriveTravelCapability(universe, jumpDrive.DistanceLimit)
+	minSecurity := -1.0
+	maxSecurity := MaxJumpDriveSecurity
+
+	if jumpDrive.Security != nil {
+		minSecurity = getSecurityLimit(jumpDrive.Security.Min, minSecurity)
+		maxSecurity = getSecurityLimit(jumpDrive.Security.Max, maxSecurity)
+	}
+
+	return SecurityFilteringTravelCapability(universe, capability, minSecurity, maxSecurity)
+}
+
+func getSecurityLimit(limit *float64, defaultValue float64) float64 {
+	if limit != nil {
+		return *limit
+	}
+
+	return defaultValue
+}
+
 func getOptimizedSystemSearchCriterion(universe universe.Universe, solarSystemId universe.Id, rule travel.TravelRule, avoid *api.AvoidEntry) search.SearchCriterion {
+	return getOptimizedSearchCriterion(search.DestinationSystemSearchCriterion(universe.SolarSystem(solarSystemId).Id()), rule, avoid)
+}
+
+func getOptimizedSearchCriterion(destination search.SearchCriterion, rule travel.TravelRule, avoid *api.AvoidEntry) search.SearchCriterion {
 	criteria := make([]search.SearchCriterion, 0)
 
-	criteria = append(criteria, search.DestinationSystemSearchCriterion(universe.SolarSystem(solarSystemId).Id()))
+	criteria = append(criteria, destination)
 	criteria = append(criteria, search.CostAwareSearchCriterion(rule))
 	if avoid != nil {
 		criteria = append(criteria, search.SystemAvoidingSearchCriterion(avoid.SolarSystems...))
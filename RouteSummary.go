@@ -0,0 +1,56 @@
+package main
+
+import (
+	"github.com/dertseha/everoute-web/api"
+)
+
+// summary aggregates the totals of a found path. It returns nil for an empty path.
+// Security and region statistics include the start system; Regions lists every
+// region in the order it was entered.
+func (searcher *routeSearch) summary(path []api.PathEntry) *api.RouteSummary {
+	if len(path) == 0 {
+		return nil
+	}
+	summary := &api.RouteSummary{
+		Jumps:       make(map[string]uint),
+		MinSecurity: 1.0,
+		MaxSecurity: -1.0,
+		Regions:     make([]api.RouteSummaryRegion, 0)}
+
+	for _, entry := range path {
+		if entry.Via != nil {
+			summary.Jumps[entry.Via.Type]++
+		}
+		jumpDistance, _ := entry.JumpDistance.(float64)
+		warpDistance, _ := entry.WarpDistance.(float64)
+		summary.JumpDistance += jumpDistance
+		summary.WarpDistance += warpDistance
+
+		security := displaySecurity(float64(searcher.universe.SolarSystem(entry.SolarSystem).TrueSecurity()))
+		if security < summary.MinSecurity {
+			summary.MinSecurity = security
+		}
+		if security > summary.MaxSecurity {
+			summary.MaxSecurity = security
+		}
+		switch securityClass(security) {
+		case api.HighSecClass:
+			summary.HighSecSystems++
+		case api.LowSecClass:
+			summary.LowSecSystems++
+		default:
+			summary.NullSecSystems++
+		}
+
+		if catalogEntry := searcher.catalog.Entry(entry.SolarSystem); catalogEntry != nil {
+			regionCount := len(summary.Regions)
+			if (regionCount == 0) || (summary.Regions[regionCount-1].RegionId != catalogEntry.RegionId) {
+				summary.Regions = append(summary.Regions, api.RouteSummaryRegion{
+					RegionId:   catalogEntry.RegionId,
+					RegionName: searcher.catalog.RegionName(catalogEntry.RegionId)})
+			}
+		}
+	}
+
+	return summary
+}
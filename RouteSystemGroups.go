@@ -0,0 +1,99 @@
+package main
+
+import (
+	"github.com/dertseha/everoute/travel"
+	"github.com/dertseha/everoute/travel/search"
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// isSystemGroup returns whether the entry selects any system of a list, region or constellation
+// instead of a single solar system.
+func isSystemGroup(entry *api.TravelEntry) bool {
+	return (len(entry.SolarSystems) > 0) || (entry.RegionId != 0) || (entry.ConstellationId != 0)
+}
+
+// getSystemGroupMembers returns the solar systems selected by any of the given fields, without duplicates.
+// Systems of regions and constellations are only considered if they are part of the universe.
+func getSystemGroupMembers(verse universe.Universe, catalog *SolarSystemCatalog, solarSystems api.SolarSystemIdList,
+	regionId, constellationId universe.Id) api.SolarSystemIdList {
+	members := make(api.SolarSystemIdList, 0, len(solarSystems))
+	added := make(map[universe.Id]bool)
+	add := func(solarSystemId universe.Id, needsCheck bool) {
+		if !added[solarSystemId] && (!needsCheck || (verse.SolarSystem(solarSystemId) != nil)) {
+			added[solarSystemId] = true
+			members = append(members, solarSystemId)
+		}
+	}
+
+	for _, solarSystemId := range solarSystems {
+		add(solarSystemId, false)
+	}
+	if regionId != 0 {
+		for _, solarSystemId := range catalog.RegionSystems(regionId) {
+			add(solarSystemId, true)
+		}
+	}
+	if constellationId != 0 {
+		for _, solarSystemId := range catalog.ConstellationSystems(constellationId) {
+			add(solarSystemId, true)
+		}
+	}
+
+	return members
+}
+
+func getTravelEntryMembers(verse universe.Universe, catalog *SolarSystemCatalog, entry *api.TravelEntry) api.SolarSystemIdList {
+	solarSystems := entry.SolarSystems
+	if entry.SolarSystem != 0 {
+		solarSystems = append(api.SolarSystemIdList{entry.SolarSystem}, solarSystems...)
+	}
+
+	return getSystemGroupMembers(verse, catalog, solarSystems, entry.RegionId, entry.ConstellationId)
+}
+
+func resolveTravelEntry(verse universe.Universe, catalog *SolarSystemCatalog, entry *api.TravelEntry) api.TravelEntry {
+	members := getTravelEntryMembers(verse, catalog, entry)
+	if len(members) == 1 {
+		return api.TravelEntry{SolarSystem: members[0]}
+	}
+
+	return api.TravelEntry{SolarSystems: members}
+}
+
+// resolveSystemGroups returns a copy of the request in which all groups are replaced by the list of their members.
+// Afterwards, the start systems are listed explicitly, and any travel entry either has a single solar system
+// or a list of solar systems.
+func (service *RouteService) resolveSystemGroups(request *api.RouteFindRequest) *api.RouteFindRequest {
+	resolved := *request
+	from := &request.Route.From
+
+	resolved.Route.From = api.FromEntry{
+		SolarSystems: getSystemGroupMembers(service.universe, service.catalog, from.SolarSystems, from.RegionId, from.ConstellationId)}
+	resolved.Route.Via = nil
+	for index := range request.Route.Via {
+		resolved.Route.Via = append(resolved.Route.Via, resolveTravelEntry(service.universe, service.catalog, &request.Route.Via[index]))
+	}
+	if request.Route.To != nil {
+		to := resolveTravelEntry(service.universe, service.catalog, request.Route.To)
+		resolved.Route.To = &to
+	}
+
+	return &resolved
+}
+
+// getTravelEntrySearchCriterion returns the criterion for a resolved travel entry, which is reached by any of its systems.
+func getTravelEntrySearchCriterion(verse universe.Universe, entry *api.TravelEntry, rule travel.TravelRule, avoid *api.AvoidEntry) search.SearchCriterion {
+	if entry.SolarSystem != 0 {
+		return getOptimizedSystemSearchCriterion(verse, entry.SolarSystem, rule, avoid)
+	}
+	members := make(map[universe.Id]bool)
+	for _, solarSystemId := range entry.SolarSystems {
+		members[solarSystemId] = true
+	}
+
+	return getOptimizedSearchCriterion(MatchingSystemSearchCriterion(func(solarSystemId universe.Id) bool {
+		return members[solarSystemId]
+	}), rule, avoid)
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/dertseha/everoute/travel/search"
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+const (
+	maxOptimizedWaypoints   = 15
+	maxWaypointOrderTimeout = 10 * time.Second
+)
+
+// waypointCosts holds the costs between the parts of a route. Entries are nil if there is no connection.
+type waypointCosts struct {
+	fromStart [][]float64
+	between   [][][]float64
+	toEnd     [][]float64
+}
+
+func addCosts(a, b []float64) []float64 {
+	result := make([]float64, len(a))
+
+	for index := range a {
+		result[index] = a[index] + b[index]
+	}
+
+	return result
+}
+
+// exploreCosts returns the costs from the sources to each of the targets, nil for unreachable targets.
+func exploreCosts(ctx context.Context, costSearch *pathCostSearch, sources api.SolarSystemIdList, targets []universe.Id) [][]float64 {
+	result := make([][]float64, len(targets))
+	pending := make(map[universe.Id][]int)
+
+	for index, target := range targets {
+		pending[target] = append(pending[target], index)
+	}
+	costSearch.explore(ctx, sources, unlimitedJumps, func(reached *reachedSystem) bool {
+		if indices, isTarget := pending[reached.solarSystemId]; isTarget {
+			delete(pending, reached.solarSystemId)
+			for _, index := range indices {
+				result[index] = reached.costs
+			}
+		}
+
+		return len(pending) > 0
+	})
+
+	return result
+}
+
+// getWaypointCosts determines the costs from the start to each waypoint, between all waypoints,
+// and from each waypoint to the end. Without end, the costs to the end are zero.
+func (searcher *routeSearch) getWaypointCosts(ctx context.Context, end *universe.Id) *waypointCosts {
+	request := searcher.request
+	costSearch := newPathCostSearch(searcher.universe, searcher.capability, request.Rules, request.Route.Avoid)
+	waypoints := make([]universe.Id, len(request.Route.Via))
+	costs := &waypointCosts{between: make([][][]float64, len(waypoints)), toEnd: make([][]float64, len(waypoints))}
+
+	for index, waypoint := range request.Route.Via {
+		waypoints[index] = waypoint.SolarSystem
+	}
+	targets := waypoints
+	if end != nil {
+		targets = append(append([]universe.Id{}, waypoints...), *end)
+	}
+
+	limiter := make(chan bool, runtime.NumCPU())
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		limiter <- true
+		costs.fromStart = exploreCosts(ctx, costSearch, request.Route.From.SolarSystems, waypoints)
+		<-limiter
+	}()
+	for index, waypoint := range waypoints {
+		waitGroup.Add(1)
+		go func(index int, waypoint universe.Id) {
+			defer waitGroup.Done()
+			limiter <- true
+			reached := exploreCosts(ctx, costSearch, api.SolarSystemIdList{waypoint}, targets)
+			costs.between[index] = reached[:len(waypoints)]
+			if end != nil {
+				costs.toEnd[index] = reached[len(waypoints)]
+			} else {
+				costs.toEnd[index] = make([]float64, len(costSearch.rules))
+			}
+			<-limiter
+		}(index, waypoint)
+	}
+	waitGroup.Wait()
+
+	return costs
+}
+
+// cheapestWaypointOrder returns the order of the waypoints with the lowest total costs, or nil if there is none.
+// All orders are considered by dynamic programming over the sets of visited waypoints.
+func cheapestWaypointOrder(costs *waypointCosts) []int {
+	count := len(costs.fromStart)
+	full := (1 << uint(count)) - 1
+	best := make([][][]float64, full+1)
+	previous := make([][]int, full+1)
+
+	for set := range best {
+		best[set] = make([][]float64, count)
+		previous[set] = make([]int, count)
+	}
+	for index := 0; index < count; index++ {
+		best[1<<uint(index)][index] = costs.fromStart[index]
+		previous[1<<uint(index)][index] = -1
+	}
+	for set := 1; set <= full; set++ {
+		for last := 0; last < count; last++ {
+			if best[set][last] == nil {
+				continue
+			}
+			for next := 0; next < count; next++ {
+				nextSet := set | (1 << uint(next))
+				if (nextSet == set) || (costs.between[last][next] == nil) {
+					continue
+				}
+				total := addCosts(best[set][last], costs.between[last][next])
+				if (best[nextSet][next] == nil) || (compareCosts(total, best[nextSet][next]) < 0) {
+					best[nextSet][next] = total
+					previous[nextSet][next] = last
+				}
+			}
+		}
+	}
+
+	var cheapest []float64
+	last := -1
+	for index := 0; index < count; index++ {
+		if (best[full][index] == nil) || (costs.toEnd[index] == nil) {
+			continue
+		}
+		total := addCosts(best[full][index], costs.toEnd[index])
+		if (cheapest == nil) || (compareCosts(total, cheapest) < 0) {
+			cheapest = total
+			last = index
+		}
+	}
+	if last < 0 {
+		return nil
+	}
+	order := make([]int, count)
+	for set, position := full, count-1; last >= 0; position-- {
+		order[position] = last
+		set, last = set&^(1<<uint(last)), previous[set][last]
+	}
+
+	return order
+}
+
+// withWaypointOrderEnd returns a copy of the request which ends where the optimized waypoint order ends.
+// For a round trip, the start system becomes the destination.
+func withWaypointOrderEnd(request *api.RouteFindRequest) *api.RouteFindRequest {
+	result := *request
+
+	if request.OptimizeVia == api.OptimizeViaReturnToStart {
+		result.Route.To = &api.TravelEntry{SolarSystem: request.Route.From.SolarSystems[0]}
+	}
+
+	return &result
+}
+
+// waypointOrderTimeout returns how long the waypoint order may be optimized within the limits of a search.
+// At most half of the search time is spent on it, so that the search itself has time left.
+func waypointOrderTimeout(limits routeSearchLimits) time.Duration {
+	if limits.timeout/2 < maxWaypointOrderTimeout {
+		return limits.timeout / 2
+	}
+
+	return maxWaypointOrderTimeout
+}
+
+// optimizeWaypointOrder puts the waypoints of the search in the order of lowest total costs.
+// If the optimization is cancelled, times out, or finds no order that connects all waypoints,
+// the given order is kept and a notice is added.
+func (searcher *routeSearch) optimizeWaypointOrder(ctx context.Context, limits routeSearchLimits) {
+	request := searcher.request
+	var end *universe.Id
+
+	if len(request.Route.Via) < 2 {
+		return
+	}
+	if request.OptimizeVia != api.OptimizeViaOpenEnd {
+		end = &request.Route.To.SolarSystem
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, waypointOrderTimeout(limits))
+	defer cancel()
+	order := cheapestWaypointOrder(searcher.getWaypointCosts(ctx, end))
+	if ctx.Err() != nil {
+		searcher.notices = append(searcher.notices, api.RouteNotice{
+			Type:    api.WaypointOrderKeptNoticeType,
+			Message: "Waypoint order could not be optimized in time; The given order is kept"})
+		return
+	}
+	if order == nil {
+		searcher.notices = append(searcher.notices, api.RouteNotice{
+			Type:    api.WaypointOrderKeptNoticeType,
+			Message: "No waypoint order connects all waypoints; The given order is kept"})
+		return
+	}
+
+	optimized := *request
+	optimized.Route.Via = make([]api.TravelEntry, 0, len(order))
+	waypoints := make([]search.SearchCriterion, 0, len(order))
+	for _, index := range order {
+		optimized.Route.Via = append(optimized.Route.Via, request.Route.Via[index])
+		waypoints = append(waypoints, searcher.waypoints[index])
+	}
+	searcher.request = &optimized
+	searcher.waypoints = waypoints
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/dertseha/everoute/travel/search"
+	"github.com/gorilla/websocket"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+func newRouteStreamErrorMessage(err error) *api.RouteStreamMessage {
+	serviceErr := serviceErrorOf(err)
+
+	return &api.RouteStreamMessage{
+		Type:     api.RouteStreamErrorMessage,
+		Error:    serviceErr.Error(),
+		Code:     serviceErr.Code(),
+		Problems: serviceErr.response().Problems}
+}
+
+// RouteWebSocketHandler streams the progress of a route search over a WebSocket.
+// The client sends a single api.RouteFindRequest, the server answers with an api.RouteStreamMessage
+// for every improved route and a final one when the search is done, then closes the connection.
+// Closing the connection from the client side cancels the search.
+type RouteWebSocketHandler struct {
+	service  *RouteService
+	upgrader websocket.Upgrader
+}
+
+func NewRouteWebSocketHandler(service *RouteService) *RouteWebSocketHandler {
+	handler := &RouteWebSocketHandler{
+		service: service,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool { return true }}}
+
+	return handler
+}
+
+func (handler *RouteWebSocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := handler.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("WebSocket upgrade failed: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	request := &api.RouteFindRequest{}
+	if err := conn.ReadJSON(request); err != nil {
+		conn.WriteJSON(newRouteStreamErrorMessage(newServiceError(api.InvalidParameterErrorCode, "", "Invalid request: %v", err)))
+		return
+	}
+	searcher, err := handler.service.newRouteSearch(request)
+	if err != nil {
+		conn.WriteJSON(newRouteStreamErrorMessage(err))
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go func() {
+		// Any further read only returns once the client closed the connection (or misbehaves).
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	improvements := 0
+	started := time.Now()
+	reason := searcher.run(ctx, defaultRouteSearchLimits, func(route *search.Route) {
+		improvements++
+		if ctx.Err() == nil {
+			if err := conn.WriteJSON(&api.RouteStreamMessage{Type: api.RouteStreamRouteMessage, Route: searcher.response(route)}); err != nil {
+				cancel()
+			}
+		}
+	})
+	if ctx.Err() == nil {
+		conn.WriteJSON(&api.RouteStreamMessage{Type: api.RouteStreamDoneMessage, Reason: reason,
+			Status: searcher.status(reason, improvements > 0, time.Since(started), improvements)})
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// ServiceError is an error reported to clients with one of the api error codes and,
+// where applicable, the JSON path of the offending request field.
+// It can carry several problems at once; The first one determines the code of the error.
+type ServiceError struct {
+	problems []api.ErrorProblem
+}
+
+func newServiceError(code string, field string, format string, args ...interface{}) *ServiceError {
+	err := &ServiceError{}
+	err.add(code, field, format, args...)
+
+	return err
+}
+
+func (err *ServiceError) add(code string, field string, format string, args ...interface{}) {
+	err.problems = append(err.problems, api.ErrorProblem{Code: code, Message: fmt.Sprintf(format, args...), Field: field})
+}
+
+func (err *ServiceError) Error() string {
+	if len(err.problems) > 1 {
+		return fmt.Sprintf("%s (and %d more problems)", err.problems[0].Message, len(err.problems)-1)
+	}
+
+	return err.problems[0].Message
+}
+
+// Code returns the api error code of the first problem.
+func (err *ServiceError) Code() string {
+	return err.problems[0].Code
+}
+
+func (err *ServiceError) response() *api.ErrorResponse {
+	return &api.ErrorResponse{Error: err.Error(), Code: err.Code(), Problems: err.problems}
+}
+
+// serviceErrorOf returns given error as ServiceError. Errors without code are reported as internal errors.
+func serviceErrorOf(err error) *ServiceError {
+	var serviceErr *ServiceError
+	if errors.As(err, &serviceErr) {
+		return serviceErr
+	}
+
+	return newServiceError(api.InternalErrorCode, "", "%s", err.Error())
+}
+
+// recoverServiceError turns a panic into an internal error. Panics are not expected
+// for any request; They are logged as the bugs they are.
+func recoverServiceError(err *error, action string) {
+	if panic := recover(); panic != nil {
+		errorText := fmt.Sprintf("Failed to %s: \"%s\"", action, panic)
+		log.Print(errorText)
+		*err = newServiceError(api.InternalErrorCode, "", "%s", errorText)
+	}
+}
+
+func (err *ServiceError) httpStatus() int {
+	switch err.Code() {
+	case api.TimeoutErrorCode:
+		return http.StatusGatewayTimeout
+	case api.BusyErrorCode:
+		return http.StatusServiceUnavailable
+	case api.InternalErrorCode:
+		return http.StatusInternalServerError
+	case api.MethodNotAllowedErrorCode:
+		return http.StatusMethodNotAllowed
+	}
+
+	return http.StatusBadRequest
+}
+
+func (err *ServiceError) jsonRpcCode() int {
+	switch err.Code() {
+	case api.UnknownSystemErrorCode, api.InvalidParameterErrorCode, api.NoCapabilityErrorCode:
+		return JsonRpcInvalidParams
+	case api.InternalErrorCode:
+		return JsonRpcInternalError
+	}
+
+	return JsonRpcServerError
+}
+
+func (err *ServiceError) grpcCode() codes.Code {
+	switch err.Code() {
+	case api.UnknownSystemErrorCode:
+		return codes.NotFound
+	case api.TimeoutErrorCode:
+		return codes.DeadlineExceeded
+	case api.BusyErrorCode:
+		return codes.ResourceExhausted
+	case api.InternalErrorCode:
+		return codes.Internal
+	}
+
+	return codes.InvalidArgument
+}
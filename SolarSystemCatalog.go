@@ -0,0 +1,155 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/data"
+)
+
+// CatalogGate is a stargate within a solar system, with its position in meters.
+type CatalogGate struct {
+	DestinationId universe.Id
+	Name          string
+	X, Y, Z       float64
+}
+
+// CatalogEntry describes a solar system, with its position in meters.
+type CatalogEntry struct {
+	Name            string
+	RegionId        universe.Id
+	ConstellationId universe.Id
+	X, Y, Z         float64
+	Gates           []CatalogGate
+}
+
+// SolarSystemCatalog keeps the descriptive data of solar systems which the universe
+// itself does not know about, such as names.
+type SolarSystemCatalog struct {
+	entries              map[universe.Id]*CatalogEntry
+	idsByName            map[string]universe.Id
+	regionNames          map[universe.Id]string
+	regionIdsByName      map[string]universe.Id
+	regionSystems        map[universe.Id][]universe.Id
+	constellationSystems map[universe.Id][]universe.Id
+	constellationNames   map[universe.Id]string
+}
+
+// newSolarSystemCatalog collects the data of all solar systems for which the predicate returns true.
+// Gates to other systems and regions without any of these systems are left out.
+func newSolarSystemCatalog(isSystemReachable func(data.SolarSystemData) bool) *SolarSystemCatalog {
+	catalog := &SolarSystemCatalog{
+		entries:              make(map[universe.Id]*CatalogEntry),
+		idsByName:            make(map[string]universe.Id),
+		regionNames:          make(map[universe.Id]string),
+		regionIdsByName:      make(map[string]universe.Id),
+		regionSystems:        make(map[universe.Id][]universe.Id),
+		constellationSystems: make(map[universe.Id][]universe.Id),
+		constellationNames:   make(map[universe.Id]string)}
+
+	for _, system := range data.SolarSystems {
+		if !isSystemReachable(system) {
+			continue
+		}
+		catalog.entries[system.SolarSystemId] = &CatalogEntry{
+			Name:            system.Name,
+			RegionId:        system.RegionId,
+			ConstellationId: system.ConstellationId,
+			X:               system.X,
+			Y:               system.Y,
+			Z:               system.Z}
+		catalog.idsByName[strings.ToLower(system.Name)] = system.SolarSystemId
+		catalog.regionSystems[system.RegionId] = append(catalog.regionSystems[system.RegionId], system.SolarSystemId)
+		catalog.constellationSystems[system.ConstellationId] = append(catalog.constellationSystems[system.ConstellationId], system.SolarSystemId)
+	}
+	for _, gate := range data.JumpGates {
+		entry, knownSystem := catalog.entries[gate.SolarSystemId]
+		destinationId, knownDestination := catalog.IdByName(getJumpGateDestinationName(gate))
+
+		if knownSystem && knownDestination {
+			entry.Gates = append(entry.Gates, CatalogGate{DestinationId: destinationId, Name: gate.Name, X: gate.X, Y: gate.Y, Z: gate.Z})
+		}
+	}
+	for _, region := range data.Regions {
+		if len(catalog.regionSystems[region.RegionId]) == 0 {
+			continue
+		}
+		catalog.regionNames[region.RegionId] = region.Name
+		catalog.regionIdsByName[strings.ToLower(region.Name)] = region.RegionId
+	}
+
+	for _, constellation := range data.Constellations {
+		if len(catalog.constellationSystems[constellation.ConstellationId]) > 0 {
+			catalog.constellationNames[constellation.ConstellationId] = constellation.Name
+		}
+	}
+
+	return catalog
+}
+
+// Entry returns the description of the identified solar system, or nil if unknown.
+func (catalog *SolarSystemCatalog) Entry(solarSystemId universe.Id) *CatalogEntry {
+	return catalog.entries[solarSystemId]
+}
+
+// Name returns the name of the identified solar system, or an empty string if unknown.
+func (catalog *SolarSystemCatalog) Name(solarSystemId universe.Id) string {
+	if entry, known := catalog.entries[solarSystemId]; known {
+		return entry.Name
+	}
+
+	return ""
+}
+
+// Gate returns the stargate within the first solar system which leads to the second one, or nil if there is none.
+func (catalog *SolarSystemCatalog) Gate(fromSolarSystemId, toSolarSystemId universe.Id) *CatalogGate {
+	if entry, known := catalog.entries[fromSolarSystemId]; known {
+		for index := range entry.Gates {
+			if entry.Gates[index].DestinationId == toSolarSystemId {
+				return &entry.Gates[index]
+			}
+		}
+	}
+
+	return nil
+}
+
+// IdByName returns the ID of the named solar system. The name is not case sensitive.
+func (catalog *SolarSystemCatalog) IdByName(name string) (id universe.Id, found bool) {
+	id, found = catalog.idsByName[strings.ToLower(strings.TrimSpace(name))]
+
+	return
+}
+
+// RegionName returns the name of the identified region, or an empty string if unknown.
+func (catalog *SolarSystemCatalog) RegionName(regionId universe.Id) string {
+	return catalog.regionNames[regionId]
+}
+
+// RegionIdByName returns the ID of the named region. The name is not case sensitive.
+func (catalog *SolarSystemCatalog) RegionIdByName(name string) (id universe.Id, found bool) {
+	id, found = catalog.regionIdsByName[strings.ToLower(strings.TrimSpace(name))]
+
+	return
+}
+
+// ConstellationName returns the name of the identified constellation, or an empty string if unknown.
+func (catalog *SolarSystemCatalog) ConstellationName(constellationId universe.Id) string {
+	return catalog.constellationNames[constellationId]
+}
+
+// HasConstellation returns whether any solar system belongs to the identified constellation.
+func (catalog *SolarSystemCatalog) HasConstellation(constellationId universe.Id) bool {
+	return len(catalog.constellationSystems[constellationId]) > 0
+}
+
+// RegionSystems returns the IDs of all solar systems within the identified region.
+func (catalog *SolarSystemCatalog) RegionSystems(regionId universe.Id) []universe.Id {
+	return catalog.regionSystems[regionId]
+}
+
+// ConstellationSystems returns the IDs of all solar systems within the identified constellation.
+func (catalog *SolarSystemCatalog) ConstellationSystems(constellationId universe.Id) []universe.Id {
+	return catalog.constellationSystems[constellationId]
+}
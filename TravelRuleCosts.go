@@ -0,0 +1,150 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/dertseha/everoute/travel"
+	"github.com/dertseha/everoute/travel/rules/jumpdistance"
+	"github.com/dertseha/everoute/travel/rules/security"
+	"github.com/dertseha/everoute/travel/rules/transitcount"
+	"github.com/dertseha/everoute/travel/rules/warpdistance"
+	"github.com/dertseha/everoute/util"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// stepCost determines the cost of a single step of a found path in terms of one rule,
+// based on the costs the search attached to the step.
+type stepCost func(step *travel.Step) float64
+
+type priorizedTravelRule struct {
+	priority uint
+	name     string
+	rule     travel.TravelRule
+	cost     stepCost
+}
+
+type priorizedTravelRules []*priorizedTravelRule
+
+func (rules priorizedTravelRules) Len() int {
+	return len(rules)
+}
+
+func (rules priorizedTravelRules) Swap(i, j int) {
+	rules[i], rules[j] = rules[j], rules[i]
+}
+
+func (rules priorizedTravelRules) Less(i, j int) bool {
+	return rules[i].priority < rules[j].priority
+}
+
+// noTravelCosts is the cost sum of a step which has not been travelled to, such as a start.
+var noTravelCosts = travel.NewStepBuilder(0).Build().EnterCosts()
+
+// typedStepCost returns the value of the costs of given type, of entering and continuing the step.
+func typedStepCost(nullCost travel.TravelCost) stepCost {
+	return func(step *travel.Step) float64 {
+		return step.EnterCosts().Cost(nullCost).Join(step.ContinueCosts().Cost(nullCost)).Value()
+	}
+}
+
+// ruleStepCost returns the value the rule sees in the costs of the step, compared to no costs at all.
+// It is used for rules which evaluate several cost types, such as the security rules.
+func ruleStepCost(rule travel.TravelRule) stepCost {
+	return func(step *travel.Step) float64 {
+		return rule.Compare(step.EnterCosts(), noTravelCosts) + rule.Compare(step.ContinueCosts(), noTravelCosts)
+	}
+}
+
+func warpDistanceStepCost(step *travel.Step) float64 {
+	return typedStepCost(warpdistance.NullCost())(step) / util.MetersPerAu
+}
+
+// getPriorizedTravelRules returns the rules of given ruleset in order of their priority.
+// Transit count is always considered, with lowest priority if not requested otherwise.
+func getPriorizedTravelRules(ruleset *api.TravelRuleset) priorizedTravelRules {
+	hasTransitCount := false
+	priorizedRules := make(priorizedTravelRules, 0)
+
+	addRule := func(priority uint, name string, rule travel.TravelRule, cost stepCost) {
+		entry := &priorizedTravelRule{priority: priority, name: name, rule: rule, cost: cost}
+		priorizedRules = append(priorizedRules, entry)
+	}
+
+	if ruleset != nil {
+		if ruleset.TransitCount != nil {
+			addRule(ruleset.TransitCount.Priority, "transitCount", transitcount.Rule(), typedStepCost(transitcount.NullCost()))
+			hasTransitCount = true
+		}
+		if ruleset.MinSecurity != nil {
+			rule := security.MinRule(ruleset.MinSecurity.Limit)
+			addRule(ruleset.MinSecurity.Priority, "minSecurity", rule, ruleStepCost(rule))
+		}
+		if ruleset.MaxSecurity != nil {
+			rule := security.MaxRule(ruleset.MaxSecurity.Limit)
+			addRule(ruleset.MaxSecurity.Priority, "maxSecurity", rule, ruleStepCost(rule))
+		}
+		if ruleset.JumpDistance != nil {
+			addRule(ruleset.JumpDistance.Priority, "jumpDistance", jumpdistance.Rule(), typedStepCost(jumpdistance.NullCost()))
+		}
+		if ruleset.WarpDistance != nil {
+			addRule(ruleset.WarpDistance.Priority, "warpDistance", warpdistance.Rule(), warpDistanceStepCost)
+		}
+	}
+	sort.Sort(priorizedRules)
+	if !hasTransitCount {
+		priorizedRules = append(priorizedRules, &priorizedTravelRule{name: "transitCount", rule: transitcount.Rule(), cost: typedStepCost(transitcount.NullCost())})
+	}
+
+	return priorizedRules
+}
+
+// stepCosts returns the costs of a single step for each rule, in order of priority.
+func (rules priorizedTravelRules) stepCosts(step *travel.Step) []float64 {
+	result := make([]float64, len(rules))
+
+	for index, rule := range rules {
+		result[index] = rule.cost(step)
+	}
+
+	return result
+}
+
+// ruleCosts names the given cost values with the rules they belong to.
+func (rules priorizedTravelRules) ruleCosts(costs []float64) []api.RuleCost {
+	result := make([]api.RuleCost, 0, len(costs))
+
+	for index, rule := range rules {
+		result = append(result, api.RuleCost{Rule: rule.name, Value: costs[index]})
+	}
+
+	return result
+}
+
+// totalCosts sums up the costs of the path entries for each rule, in order of priority.
+func (rules priorizedTravelRules) totalCosts(path []api.PathEntry) []api.RuleCost {
+	result := make([]api.RuleCost, 0, len(rules))
+
+	for _, rule := range rules {
+		result = append(result, api.RuleCost{Rule: rule.name})
+	}
+	for _, entry := range path {
+		for ruleIndex, cost := range entry.Costs {
+			result[ruleIndex].Value += cost.Value
+		}
+	}
+
+	return result
+}
+
+// compareRuleCosts compares two lists of costs as created by totalCosts, with the first
+// difference deciding. It returns a negative value if a is cheaper than b.
+func compareRuleCosts(a, b []api.RuleCost) float64 {
+	for index := 0; (index < len(a)) && (index < len(b)); index++ {
+		if a[index].Value != b[index].Value {
+			return a[index].Value - b[index].Value
+		}
+	}
+
+	return 0.0
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dertseha/everoute/travel/capabilities/jumpgate"
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// Supported formats of the universe graph export.
+const (
+	GraphFormatGraphML = "graphml"
+	GraphFormatDot     = "dot"
+	GraphFormatJson    = "json"
+)
+
+// buildUniverseGraph collects the solar systems of the universe as nodes and their gate jumps, as the router
+// uses them, as undirected edges. The catalog only adds descriptions and gate positions.
+// If regions are given, only systems within these regions are considered.
+func buildUniverseGraph(verse universe.Universe, catalog *SolarSystemCatalog, regions map[universe.Id]bool) *api.UniverseGraph {
+	graph := &api.UniverseGraph{Nodes: make([]api.GraphNode, 0), Edges: make([]api.GraphEdge, 0)}
+	included := make(map[universe.Id]bool)
+	ids := verse.SolarSystemIds()
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, solarSystemId := range ids {
+		entry := catalog.Entry(solarSystemId)
+		if (entry == nil) || ((len(regions) > 0) && !regions[entry.RegionId]) {
+			continue
+		}
+		trueSec := float64(verse.SolarSystem(solarSystemId).TrueSecurity())
+
+		included[solarSystemId] = true
+		graph.Nodes = append(graph.Nodes, api.GraphNode{
+			SolarSystem:     solarSystemId,
+			Name:            entry.Name,
+			RegionId:        entry.RegionId,
+			RegionName:      catalog.RegionName(entry.RegionId),
+			ConstellationId: entry.ConstellationId,
+			Security:        displaySecurity(trueSec),
+			TrueSecurity:    trueSec,
+			Position:        api.Position{X: entry.X, Y: entry.Y, Z: entry.Z}})
+	}
+	for _, node := range graph.Nodes {
+		for _, jump := range verse.SolarSystem(node.SolarSystem).Jumps(jumpgate.JumpType) {
+			destinationId := jump.DestinationId()
+			if (destinationId < node.SolarSystem) || !included[destinationId] {
+				continue
+			}
+			edge := api.GraphEdge{Source: node.SolarSystem, Target: destinationId}
+			if gate := catalog.Gate(node.SolarSystem, destinationId); gate != nil {
+				edge.SourceGate = api.Position{X: gate.X, Y: gate.Y, Z: gate.Z}
+			}
+			if gate := catalog.Gate(destinationId, node.SolarSystem); gate != nil {
+				edge.TargetGate = api.Position{X: gate.X, Y: gate.Y, Z: gate.Z}
+			}
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+
+	return graph
+}
+
+// parseRegionFilter resolves a list of region IDs or names.
+func parseRegionFilter(catalog *SolarSystemCatalog, values []string) (map[universe.Id]bool, error) {
+	regions := make(map[universe.Id]bool)
+
+	for _, value := range values {
+		for _, token := range strings.Split(value, ",") {
+			token = strings.TrimSpace(token)
+			if token == "" {
+				continue
+			}
+			if id, err := strconv.ParseInt(token, 10, 64); err == nil {
+				if catalog.RegionName(universe.Id(id)) == "" {
+					return nil, newServiceError(api.InvalidParameterErrorCode, "region", "Unknown region <%s>", token)
+				}
+				regions[universe.Id(id)] = true
+			} else if regionId, found := catalog.RegionIdByName(token); found {
+				regions[regionId] = true
+			} else {
+				return nil, newServiceError(api.InvalidParameterErrorCode, "region", "Unknown region <%s>", token)
+			}
+		}
+	}
+
+	return regions, nil
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+type graphmlKey struct {
+	Id   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphmlData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphmlNode struct {
+	Id   string        `xml:"id,attr"`
+	Data []graphmlData `xml:"data"`
+}
+
+type graphmlEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphmlData `xml:"data"`
+}
+
+type graphmlGraph struct {
+	Id          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphmlNode `xml:"node"`
+	Edges       []graphmlEdge `xml:"edge"`
+}
+
+type graphmlDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphmlKey `xml:"key"`
+	Graph   graphmlGraph `xml:"graph"`
+}
+
+func writeGraphML(w io.Writer, graph *api.UniverseGraph) error {
+	document := graphmlDocument{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphmlKey{
+			{Id: "name", For: "node", Name: "name", Type: "string"},
+			{Id: "regionId", For: "node", Name: "regionId", Type: "long"},
+			{Id: "regionName", For: "node", Name: "regionName", Type: "string"},
+			{Id: "constellationId", For: "node", Name: "constellationId", Type: "long"},
+			{Id: "security", For: "node", Name: "security", Type: "double"},
+			{Id: "trueSecurity", For: "node", Name: "trueSecurity", Type: "double"},
+			{Id: "x", For: "node", Name: "x", Type: "double"},
+			{Id: "y", For: "node", Name: "y", Type: "double"},
+			{Id: "z", For: "node", Name: "z", Type: "double"},
+			{Id: "sourceGateX", For: "edge", Name: "sourceGateX", Type: "double"},
+			{Id: "sourceGateY", For: "edge", Name: "sourceGateY", Type: "double"},
+			{Id: "sourceGateZ", For: "edge", Name: "sourceGateZ", Type: "double"},
+			{Id: "targetGateX", For: "edge", Name: "targetGateX", Type: "double"},
+			{Id: "targetGateY", For: "edge", Name: "targetGateY", Type: "double"},
+			{Id: "targetGateZ", For: "edge", Name: "targetGateZ", Type: "double"}},
+		Graph: graphmlGraph{Id: "universe", EdgeDefault: "undirected"}}
+
+	for _, node := range graph.Nodes {
+		document.Graph.Nodes = append(document.Graph.Nodes, graphmlNode{
+			Id: fmt.Sprintf("%d", node.SolarSystem),
+			Data: []graphmlData{
+				{Key: "name", Value: node.Name},
+				{Key: "regionId", Value: fmt.Sprintf("%d", node.RegionId)},
+				{Key: "regionName", Value: node.RegionName},
+				{Key: "constellationId", Value: fmt.Sprintf("%d", node.ConstellationId)},
+				{Key: "security", Value: formatFloat(node.Security)},
+				{Key: "trueSecurity", Value: formatFloat(node.TrueSecurity)},
+				{Key: "x", Value: formatFloat(node.Position.X)},
+				{Key: "y", Value: formatFloat(node.Position.Y)},
+				{Key: "z", Value: formatFloat(node.Position.Z)}}})
+	}
+	for _, edge := range graph.Edges {
+		document.Graph.Edges = append(document.Graph.Edges, graphmlEdge{
+			Source: fmt.Sprintf("%d", edge.Source),
+			Target: fmt.Sprintf("%d", edge.Target),
+			Data: []graphmlData{
+				{Key: "sourceGateX", Value: formatFloat(edge.SourceGate.X)},
+				{Key: "sourceGateY", Value: formatFloat(edge.SourceGate.Y)},
+				{Key: "sourceGateZ", Value: formatFloat(edge.SourceGate.Z)},
+				{Key: "targetGateX", Value: formatFloat(edge.TargetGate.X)},
+				{Key: "targetGateY", Value: formatFloat(edge.TargetGate.Y)},
+				{Key: "targetGateZ", Value: formatFloat(edge.TargetGate.Z)}}})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(&document); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+func writeDot(w io.Writer, graph *api.UniverseGraph) error {
+	if _, err := io.WriteString(w, "graph universe {\n"); err != nil {
+		return err
+	}
+	for _, node := range graph.Nodes {
+		_, err := fmt.Fprintf(w, "  %d [label=%q, regionId=%d, regionName=%q, constellationId=%d, security=%s, trueSecurity=%s, x=%s, y=%s, z=%s];\n",
+			node.SolarSystem, node.Name, node.RegionId, node.RegionName, node.ConstellationId,
+			formatFloat(node.Security), formatFloat(node.TrueSecurity),
+			formatFloat(node.Position.X), formatFloat(node.Position.Y), formatFloat(node.Position.Z))
+		if err != nil {
+			return err
+		}
+	}
+	for _, edge := range graph.Edges {
+		_, err := fmt.Fprintf(w, "  %d -- %d [sourceGate=\"%s,%s,%s\", targetGate=\"%s,%s,%s\"];\n",
+			edge.Source, edge.Target,
+			formatFloat(edge.SourceGate.X), formatFloat(edge.SourceGate.Y), formatFloat(edge.SourceGate.Z),
+			formatFloat(edge.TargetGate.X), formatFloat(edge.TargetGate.Y), formatFloat(edge.TargetGate.Z))
+		if err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "}\n")
+
+	return err
+}
+
+func writeUniverseGraph(w io.Writer, graph *api.UniverseGraph, format string) error {
+	switch format {
+	case GraphFormatGraphML:
+		return writeGraphML(w, graph)
+	case GraphFormatDot:
+		return writeDot(w, graph)
+	case GraphFormatJson:
+		return json.NewEncoder(w).Encode(graph)
+	}
+
+	return newServiceError(api.InvalidParameterErrorCode, "format", "Unknown format <%s>", format)
+}
+
+var graphContentTypes = map[string]string{
+	GraphFormatGraphML: "application/graphml+xml",
+	GraphFormatDot:     "text/vnd.graphviz",
+	GraphFormatJson:    "application/json"}
+
+// universeGraphHandler serves the universe graph. The query parameter format selects
+// one of graphml, dot and json (the default), region optionally lists region IDs or names.
+func universeGraphHandler(verse universe.Universe, catalog *SolarSystemCatalog) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		format := query.Get("format")
+		if format == "" {
+			format = GraphFormatJson
+		}
+		contentType, knownFormat := graphContentTypes[format]
+		if !knownFormat {
+			writeServiceError(w, newServiceError(api.InvalidParameterErrorCode, "format", "Unknown format <%s>", format))
+			return
+		}
+		regions, err := parseRegionFilter(catalog, query["region"])
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		writeUniverseGraph(w, buildUniverseGraph(verse, catalog, regions), format)
+	})
+}
+
+// exportUniverseGraph is the command line variant of the graph export, writing to the given writer.
+// Arguments are -format (graphml, dot or json) and -region, which can be repeated.
+func exportUniverseGraph(w io.Writer, verse universe.Universe, catalog *SolarSystemCatalog, args []string) error {
+	var regionValues []string
+	flags := flag.NewFlagSet("export-graph", flag.ContinueOnError)
+	format := flags.String("format", GraphFormatGraphML, "Output format: graphml, dot or json")
+	flags.Func("region", "Region ID or name to limit the graph to, can be repeated", func(value string) error {
+		regionValues = append(regionValues, value)
+		return nil
+	})
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	regions, err := parseRegionFilter(catalog, regionValues)
+	if err != nil {
+		return err
+	}
+
+	return writeUniverseGraph(w, buildUniverseGraph(verse, catalog, regions), *format)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+	"github.com/dertseha/everoute-web/routepb"
+)
+
+// UniverseGrpcServer provides the UniverseService via gRPC.
+type UniverseGrpcServer struct {
+	routepb.UnimplementedUniverseServer
+	service *UniverseService
+}
+
+func NewUniverseGrpcServer(service *UniverseService) *UniverseGrpcServer {
+	server := &UniverseGrpcServer{
+		service: service}
+
+	return server
+}
+
+func (server *UniverseGrpcServer) InRange(ctx context.Context, request *routepb.InRangeRequest) (*routepb.InRangeResponse, error) {
+	httpRequest := (&http.Request{}).WithContext(ctx)
+	response := &api.InRangeResponse{}
+
+	err := server.service.InRange(httpRequest, &api.InRangeRequest{
+		SolarSystem: universe.Id(request.GetSolarSystem()),
+		Range:       request.GetRange()}, response)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	result := &routepb.InRangeResponse{}
+	for _, system := range response.Systems {
+		result.Systems = append(result.Systems, &routepb.InRangeSystem{
+			SolarSystem:  int64(system.SolarSystem),
+			Name:         system.Name,
+			Distance:     system.Distance,
+			Security:     system.Security,
+			TrueSecurity: system.TrueSecurity,
+			CynoTarget:   system.CynoTarget})
+	}
+
+	return result, nil
+}
+
+func positionToProto(position *api.Position) *routepb.Position {
+	if position == nil {
+		return nil
+	}
+
+	return &routepb.Position{X: position.X, Y: position.Y, Z: position.Z}
+}
+
+func (server *UniverseGrpcServer) System(ctx context.Context, request *routepb.SystemRequest) (*routepb.SystemResponse, error) {
+	httpRequest := (&http.Request{}).WithContext(ctx)
+	response := &api.SystemResponse{}
+
+	err := server.service.System(httpRequest, &api.SystemRequest{
+		SolarSystem: universe.Id(request.GetSolarSystem()),
+		Name:        request.GetName()}, response)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	result := &routepb.SystemResponse{
+		SolarSystem:       int64(response.SolarSystem),
+		Name:              response.Name,
+		RegionId:          int64(response.RegionId),
+		RegionName:        response.RegionName,
+		ConstellationId:   int64(response.ConstellationId),
+		ConstellationName: response.ConstellationName,
+		Security:          response.Security,
+		TrueSecurity:      response.TrueSecurity,
+		Position:          positionToProto(&response.Position)}
+	for _, gate := range response.Gates {
+		result.Gates = append(result.Gates, &routepb.GateNeighbour{
+			SolarSystem:     int64(gate.SolarSystem),
+			Name:            gate.Name,
+			Gate:            positionToProto(&gate.Gate),
+			DestinationGate: positionToProto(gate.DestinationGate)})
+	}
+	for _, neighbour := range response.JumpDriveNeighbours {
+		result.JumpDriveNeighbours = append(result.JumpDriveNeighbours, &routepb.JumpDriveNeighbour{
+			SolarSystem: int64(neighbour.SolarSystem),
+			Name:        neighbour.Name,
+			Distance:    neighbour.Distance})
+	}
+
+	return result, nil
+}
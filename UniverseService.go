@@ -0,0 +1,142 @@
+package main
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/dertseha/everoute/travel/capabilities/jumpdrive"
+	"github.com/dertseha/everoute/universe"
+
+	"github.com/dertseha/everoute-web/api"
+)
+
+// UniverseService provides information about the universe the routes are searched in.
+type UniverseService struct {
+	universe    universe.Universe
+	catalog     *SolarSystemCatalog
+	cynoJammers *CynoJammerList
+}
+
+func NewUniverseService(universe universe.Universe, catalog *SolarSystemCatalog, cynoJammers *CynoJammerList) *UniverseService {
+	service := &UniverseService{
+		universe:    universe,
+		catalog:     catalog,
+		cynoJammers: cynoJammers}
+
+	return service
+}
+
+func (service *UniverseService) solarSystem(solarSystemId universe.Id) (universe.SolarSystem, error) {
+	solarSystem := service.universe.SolarSystem(solarSystemId)
+	if solarSystem == nil {
+		return nil, newServiceError(api.UnknownSystemErrorCode, "solarSystem", "Unknown solar system %v", solarSystemId)
+	}
+
+	return solarSystem, nil
+}
+
+func (service *UniverseService) resolveSystemRequest(request *api.SystemRequest) (universe.Id, error) {
+	if request.Name != "" {
+		solarSystemId, found := service.catalog.IdByName(request.Name)
+		if !found {
+			return 0, newServiceError(api.UnknownSystemErrorCode, "name", "Unknown solar system <%s>", request.Name)
+		}
+		return solarSystemId, nil
+	}
+	_, err := service.solarSystem(request.SolarSystem)
+
+	return request.SolarSystem, err
+}
+
+func (service *UniverseService) jumpDriveNeighbours(solarSystemId universe.Id, distanceLimit float64) []api.JumpDriveNeighbour {
+	capability := jumpdrive.JumpDriveTravelCapability(service.universe, distanceLimit)
+	start := getStartSystems(service.universe, &api.FromEntry{SolarSystems: api.SolarSystemIdList{solarSystemId}})[0]
+	result := make([]api.JumpDriveNeighbour, 0)
+
+	for _, path := range capability.NextPaths(start) {
+		entry := pathEntryFromStep(path.Step())
+		distance, _ := entry.JumpDistance.(float64)
+
+		result = append(result, api.JumpDriveNeighbour{
+			SolarSystem: entry.SolarSystem,
+			Name:        service.catalog.Name(entry.SolarSystem),
+			Distance:    distance})
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Distance < result[j].Distance })
+
+	return result
+}
+
+// System describes a solar system, identified either by ID or by name.
+func (service *UniverseService) System(r *http.Request, request *api.SystemRequest, response *api.SystemResponse) (err error) {
+	defer recoverServiceError(&err, "query universe")
+
+	solarSystemId, err := service.resolveSystemRequest(request)
+	if err != nil {
+		return
+	}
+	solarSystem, err := service.solarSystem(solarSystemId)
+	if err != nil {
+		return
+	}
+	entry := service.catalog.Entry(solarSystemId)
+	trueSec := float64(solarSystem.TrueSecurity())
+
+	response.SolarSystem = solarSystemId
+	response.Name = entry.Name
+	response.RegionId = entry.RegionId
+	response.RegionName = service.catalog.RegionName(entry.RegionId)
+	response.ConstellationId = entry.ConstellationId
+	response.ConstellationName = service.catalog.ConstellationName(entry.ConstellationId)
+	response.Security = displaySecurity(trueSec)
+	response.TrueSecurity = trueSec
+	response.Position = api.Position{X: entry.X, Y: entry.Y, Z: entry.Z}
+	response.Gates = make([]api.GateNeighbour, 0, len(entry.Gates))
+	for _, gate := range entry.Gates {
+		if service.universe.SolarSystem(gate.DestinationId) == nil {
+			continue
+		}
+		neighbour := api.GateNeighbour{
+			SolarSystem: gate.DestinationId,
+			Name:        service.catalog.Name(gate.DestinationId),
+			Gate:        api.Position{X: gate.X, Y: gate.Y, Z: gate.Z}}
+		for _, destinationGate := range service.catalog.Entry(gate.DestinationId).Gates {
+			if destinationGate.DestinationId == solarSystemId {
+				neighbour.DestinationGate = &api.Position{X: destinationGate.X, Y: destinationGate.Y, Z: destinationGate.Z}
+			}
+		}
+		response.Gates = append(response.Gates, neighbour)
+	}
+	response.JumpDriveNeighbours = service.jumpDriveNeighbours(solarSystemId, MaxJumpDriveRange)
+
+	return
+}
+
+// InRange lists all systems within given jump drive range, ordered by distance.
+func (service *UniverseService) InRange(r *http.Request, request *api.InRangeRequest, response *api.InRangeResponse) (err error) {
+	defer recoverServiceError(&err, "query universe")
+
+	if (request.Range <= 0.0) || (request.Range > MaxJumpDriveRange) {
+		return newServiceError(api.InvalidParameterErrorCode, "range", "Range must be greater than 0 and at most %v light years", MaxJumpDriveRange)
+	}
+	if _, err = service.solarSystem(request.SolarSystem); err != nil {
+		return
+	}
+
+	jammedSystems := service.cynoJammers.JammedSystems()
+	response.Systems = make([]api.InRangeSystem, 0)
+	for _, neighbour := range service.jumpDriveNeighbours(request.SolarSystem, request.Range) {
+		trueSec := float64(service.universe.SolarSystem(neighbour.SolarSystem).TrueSecurity())
+		security := displaySecurity(trueSec)
+
+		response.Systems = append(response.Systems, api.InRangeSystem{
+			SolarSystem:  neighbour.SolarSystem,
+			Name:         neighbour.Name,
+			Distance:     neighbour.Distance,
+			Security:     security,
+			TrueSecurity: trueSec,
+			CynoTarget:   (security <= MaxJumpDriveSecurity) && !jammedSystems[neighbour.SolarSystem]})
+	}
+
+	return
+}
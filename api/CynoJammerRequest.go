@@ -0,0 +1,24 @@
+package api
+
+import (
+	"time"
+
+	"github.com/dertseha/everoute/universe"
+)
+
+type CynoJammerEntry struct {
+	SolarSystem universe.Id `json:"solarSystem"`
+	Expires     *time.Time  `json:"expires,omitempty"`
+}
+
+type CynoJammerAddRequest struct {
+	SolarSystems SolarSystemIdList `json:"solarSystems"`
+	Expires      *time.Time        `json:"expires,omitempty"`
+}
+
+type CynoJammerRemoveRequest struct {
+	SolarSystems SolarSystemIdList `json:"solarSystems"`
+}
+
+type CynoJammerListRequest struct {
+}
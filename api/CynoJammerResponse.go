@@ -0,0 +1,5 @@
+package api
+
+type CynoJammerListResponse struct {
+	Entries []CynoJammerEntry `json:"entries"`
+}
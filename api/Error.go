@@ -0,0 +1,23 @@
+package api
+
+const (
+	UnknownSystemErrorCode    = "UNKNOWN_SYSTEM"
+	InvalidParameterErrorCode = "INVALID_PARAMETER"
+	NoCapabilityErrorCode     = "NO_CAPABILITY"
+	TimeoutErrorCode          = "TIMEOUT"
+	BusyErrorCode             = "BUSY"
+	InternalErrorCode         = "INTERNAL"
+	MethodNotAllowedErrorCode = "METHOD_NOT_ALLOWED"
+)
+
+type ErrorProblem struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Field   string `json:"field,omitempty"`
+}
+
+type ErrorResponse struct {
+	Error    string         `json:"error"`
+	Code     string         `json:"code"`
+	Problems []ErrorProblem `json:"problems,omitempty"`
+}
@@ -5,26 +5,49 @@ import (
 )
 
 type FromEntry struct {
-	SolarSystems SolarSystemIdList `json:"solarSystems"`
+	SolarSystems    SolarSystemIdList `json:"solarSystems,omitempty"`
+	RegionId        universe.Id       `json:"regionId,omitempty"`
+	ConstellationId universe.Id       `json:"constellationId,omitempty"`
 }
 
 type TravelEntry struct {
-	SolarSystem universe.Id `json:"solarSystem"`
+	SolarSystem     universe.Id       `json:"solarSystem,omitempty"`
+	SolarSystems    SolarSystemIdList `json:"solarSystems,omitempty"`
+	RegionId        universe.Id       `json:"regionId,omitempty"`
+	ConstellationId universe.Id       `json:"constellationId,omitempty"`
 }
 
 type AvoidEntry struct {
 	SolarSystems SolarSystemIdList `json:"solarSystems"`
 }
 
+type NearestEntry struct {
+	SecurityClass         string      `json:"securityClass,omitempty"`
+	RegionId              universe.Id `json:"regionId,omitempty"`
+	ConstellationId       universe.Id `json:"constellationId,omitempty"`
+	AdjacentSecurityClass string      `json:"adjacentSecurityClass,omitempty"`
+}
+
 type RouteEntry struct {
-	From  FromEntry     `json:"from"`
-	Via   []TravelEntry `json:"via,omitempty"`
-	To    *TravelEntry  `json:"to,omitempty"`
-	Avoid *AvoidEntry   `json:"avoid,omitempty"`
+	From    FromEntry     `json:"from"`
+	Via     []TravelEntry `json:"via,omitempty"`
+	To      *TravelEntry  `json:"to,omitempty"`
+	Nearest *NearestEntry `json:"nearest,omitempty"`
+	Avoid   *AvoidEntry   `json:"avoid,omitempty"`
 }
 
+const (
+	OptimizeViaFixedDestination = "fixedDestination"
+	OptimizeViaReturnToStart    = "returnToStart"
+	OptimizeViaOpenEnd          = "openEnd"
+)
+
 type RouteFindRequest struct {
-	Route        RouteEntry         `json:"route"`
-	Capabilities TravelCapabilities `json:"capabilities"`
-	Rules        *TravelRuleset     `json:"rules,omitempty"`
+	Route              RouteEntry         `json:"route"`
+	Capabilities       TravelCapabilities `json:"capabilities"`
+	Rules              *TravelRuleset     `json:"rules,omitempty"`
+	Alternatives       uint               `json:"alternatives,omitempty"`
+	AlternativeOverlap *float64           `json:"alternativeOverlap,omitempty"`
+	Details            bool               `json:"details,omitempty"`
+	OptimizeVia        string             `json:"optimizeVia,omitempty"`
 }
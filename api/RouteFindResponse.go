@@ -4,12 +4,108 @@ import (
 	"github.com/dertseha/everoute/universe"
 )
 
+type Stargate struct {
+	Name     string   `json:"name"`
+	Position Position `json:"position"`
+}
+
+type PathVia struct {
+	Type     string    `json:"type"`
+	Stargate *Stargate `json:"stargate,omitempty"`
+}
+
 type PathEntry struct {
-	SolarSystem  universe.Id `json:"solarSystem"`
-	JumpDistance interface{} `json:"jumpDistance,omitempty"`
-	WarpDistance interface{} `json:"warpDistance,omitempty"`
+	SolarSystem       universe.Id `json:"solarSystem"`
+	JumpDistance      interface{} `json:"jumpDistance,omitempty" schema:"number"`
+	WarpDistance      interface{} `json:"warpDistance,omitempty" schema:"number"`
+	Via               *PathVia    `json:"via,omitempty"`
+	Costs             []RuleCost  `json:"costs,omitempty"`
+	Name              string      `json:"name,omitempty"`
+	ConstellationId   universe.Id `json:"constellationId,omitempty"`
+	ConstellationName string      `json:"constellationName,omitempty"`
+	RegionId          universe.Id `json:"regionId,omitempty"`
+	RegionName        string      `json:"regionName,omitempty"`
+	Security          interface{} `json:"security,omitempty" schema:"number"`
+	TrueSecurity      interface{} `json:"trueSecurity,omitempty" schema:"number"`
+	SecurityClass     string      `json:"securityClass,omitempty"`
+}
+
+const (
+	HighSecClass = "highSec"
+	LowSecClass  = "lowSec"
+	NullSecClass = "nullSec"
+)
+
+type RouteNotice struct {
+	Type         string            `json:"type"`
+	Message      string            `json:"message"`
+	SolarSystems SolarSystemIdList `json:"solarSystems,omitempty"`
+}
+
+const (
+	CynoJammedNoticeType           = "cynoJammed"
+	DistanceLimitClampedNoticeType = "distanceLimitClamped"
+	WaypointOrderKeptNoticeType    = "waypointOrderKept"
+)
+
+type RuleCost struct {
+	Rule  string  `json:"rule"`
+	Value float64 `json:"value"`
+}
+
+type RouteAlternative struct {
+	Path    []PathEntry   `json:"path"`
+	Costs   []RuleCost    `json:"costs"`
+	Summary *RouteSummary `json:"summary,omitempty"`
+}
+
+type RouteSummaryRegion struct {
+	RegionId   universe.Id `json:"regionId"`
+	RegionName string      `json:"regionName"`
+}
+
+type RouteSummary struct {
+	Jumps          map[string]uint      `json:"jumps"`
+	JumpDistance   float64              `json:"jumpDistance"`
+	WarpDistance   float64              `json:"warpDistance"`
+	MinSecurity    float64              `json:"minSecurity"`
+	MaxSecurity    float64              `json:"maxSecurity"`
+	HighSecSystems uint                 `json:"highSecSystems"`
+	LowSecSystems  uint                 `json:"lowSecSystems"`
+	NullSecSystems uint                 `json:"nullSecSystems"`
+	Regions        []RouteSummaryRegion `json:"regions"`
+}
+
+const (
+	RouteSearchComplete  = "complete"
+	RouteSearchTimedOut  = "timedOut"
+	RouteSearchNoRoute   = "noRoute"
+	RouteSearchCancelled = "cancelled"
+)
+
+type RouteSearchStatus struct {
+	State        string  `json:"state"`
+	Reason       string  `json:"reason"`
+	Elapsed      float64 `json:"elapsed"`
+	Optimal      bool    `json:"optimal"`
+	Improvements int     `json:"improvements"`
 }
 
 type RouteFindResponse struct {
-	Path []PathEntry `json:"path"`
+	Path          []PathEntry        `json:"path"`
+	Summary       *RouteSummary      `json:"summary,omitempty"`
+	Costs         []RuleCost         `json:"costs,omitempty"`
+	Notices       []RouteNotice      `json:"notices,omitempty"`
+	Alternatives  []RouteAlternative `json:"alternatives,omitempty"`
+	Status        *RouteSearchStatus `json:"status,omitempty"`
+	WaypointOrder SolarSystemIdList  `json:"waypointOrder,omitempty"`
+	Start         universe.Id        `json:"start,omitempty"`
+	Destination   universe.Id        `json:"destination,omitempty"`
 }
+
+const (
+	SearchReasonCompleted = "completed"
+	SearchReasonTimedOut  = "timedOut"
+	SearchReasonIdle      = "idle"
+	SearchReasonCancelled = "cancelled"
+)
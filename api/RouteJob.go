@@ -0,0 +1,24 @@
+package api
+
+const (
+	RouteJobRunning = "running"
+	RouteJobDone    = "done"
+)
+
+type RouteJobRequest struct {
+	JobId string `json:"jobId"`
+}
+
+type RouteSubmitResponse struct {
+	JobId string `json:"jobId"`
+}
+
+type RouteStatusResponse struct {
+	JobId        string             `json:"jobId"`
+	State        string             `json:"state"`
+	Reason       string             `json:"reason,omitempty"`
+	Elapsed      float64            `json:"elapsed"`
+	Improvements int                `json:"improvements"`
+	Route        *RouteFindResponse `json:"route,omitempty"`
+	Error        *ErrorResponse     `json:"error,omitempty"`
+}
@@ -0,0 +1,10 @@
+package api
+
+type RouteMatrixRequest struct {
+	Origins          SolarSystemIdList  `json:"origins"`
+	Destinations     SolarSystemIdList  `json:"destinations"`
+	Avoid            *AvoidEntry        `json:"avoid,omitempty"`
+	Capabilities     TravelCapabilities `json:"capabilities"`
+	Rules            *TravelRuleset     `json:"rules,omitempty"`
+	IncludeDistances bool               `json:"includeDistances,omitempty"`
+}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"github.com/dertseha/everoute/universe"
+)
+
+type RouteMatrixEntry struct {
+	Destination  universe.Id `json:"destination"`
+	Reachable    bool        `json:"reachable"`
+	Jumps        int         `json:"jumps"`
+	JumpDistance *float64    `json:"jumpDistance,omitempty"`
+	WarpDistance *float64    `json:"warpDistance,omitempty"`
+}
+
+type RouteMatrixRow struct {
+	Origin  universe.Id        `json:"origin"`
+	Entries []RouteMatrixEntry `json:"entries"`
+}
+
+type RouteMatrixResponse struct {
+	Rows []RouteMatrixRow `json:"rows"`
+}
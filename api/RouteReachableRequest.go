@@ -0,0 +1,9 @@
+package api
+
+type RouteReachableRequest struct {
+	From         FromEntry          `json:"from"`
+	MaxJumps     uint               `json:"maxJumps"`
+	Avoid        *AvoidEntry        `json:"avoid,omitempty"`
+	Capabilities TravelCapabilities `json:"capabilities"`
+	Rules        *TravelRuleset     `json:"rules,omitempty"`
+}
@@ -0,0 +1,17 @@
+package api
+
+import (
+	"github.com/dertseha/everoute/universe"
+)
+
+type ReachableSystem struct {
+	SolarSystem  universe.Id `json:"solarSystem"`
+	Jumps        int         `json:"jumps"`
+	JumpDistance float64     `json:"jumpDistance"`
+	WarpDistance float64     `json:"warpDistance"`
+	Costs        []RuleCost  `json:"costs"`
+}
+
+type RouteReachableResponse struct {
+	Systems []ReachableSystem `json:"systems"`
+}
@@ -0,0 +1,17 @@
+package api
+
+const (
+	RouteStreamRouteMessage = "route"
+	RouteStreamDoneMessage  = "done"
+	RouteStreamErrorMessage = "error"
+)
+
+type RouteStreamMessage struct {
+	Type     string             `json:"type"`
+	Route    *RouteFindResponse `json:"route,omitempty"`
+	Reason   string             `json:"reason,omitempty"`
+	Status   *RouteSearchStatus `json:"status,omitempty"`
+	Error    string             `json:"error,omitempty"`
+	Code     string             `json:"code,omitempty"`
+	Problems []ErrorProblem     `json:"problems,omitempty"`
+}
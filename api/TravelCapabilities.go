@@ -1,11 +1,18 @@
 package api
 
+type SecurityLimits struct {
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+}
+
 type JumpGateTravelCapability struct {
-	AvoidHighSec bool `json:"avoidHighSec"`
+	AvoidHighSec bool            `json:"avoidHighSec" schema:",optional"`
+	Security     *SecurityLimits `json:"security,omitempty"`
 }
 
 type JumpDriveTravelCapability struct {
-	DistanceLimit float64 `json:"distanceLimit"`
+	DistanceLimit float64         `json:"distanceLimit"`
+	Security      *SecurityLimits `json:"security,omitempty"`
 }
 
 type TravelCapabilities struct {
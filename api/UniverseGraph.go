@@ -0,0 +1,28 @@
+package api
+
+import (
+	"github.com/dertseha/everoute/universe"
+)
+
+type GraphNode struct {
+	SolarSystem     universe.Id `json:"solarSystem"`
+	Name            string      `json:"name"`
+	RegionId        universe.Id `json:"regionId"`
+	RegionName      string      `json:"regionName"`
+	ConstellationId universe.Id `json:"constellationId"`
+	Security        float64     `json:"security"`
+	TrueSecurity    float64     `json:"trueSecurity"`
+	Position        Position    `json:"position"`
+}
+
+type GraphEdge struct {
+	Source     universe.Id `json:"source"`
+	Target     universe.Id `json:"target"`
+	SourceGate Position    `json:"sourceGate"`
+	TargetGate Position    `json:"targetGate"`
+}
+
+type UniverseGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
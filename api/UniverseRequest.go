@@ -0,0 +1,15 @@
+package api
+
+import (
+	"github.com/dertseha/everoute/universe"
+)
+
+type InRangeRequest struct {
+	SolarSystem universe.Id `json:"solarSystem"`
+	Range       float64     `json:"range"`
+}
+
+type SystemRequest struct {
+	SolarSystem universe.Id `json:"solarSystem,omitempty"`
+	Name        string      `json:"name,omitempty"`
+}
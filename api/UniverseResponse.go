@@ -0,0 +1,51 @@
+package api
+
+import (
+	"github.com/dertseha/everoute/universe"
+)
+
+type InRangeSystem struct {
+	SolarSystem  universe.Id `json:"solarSystem"`
+	Name         string      `json:"name"`
+	Distance     float64     `json:"distance"`
+	Security     float64     `json:"security"`
+	TrueSecurity float64     `json:"trueSecurity"`
+	CynoTarget   bool        `json:"cynoTarget"`
+}
+
+type InRangeResponse struct {
+	Systems []InRangeSystem `json:"systems"`
+}
+
+type Position struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+type GateNeighbour struct {
+	SolarSystem     universe.Id `json:"solarSystem"`
+	Name            string      `json:"name"`
+	Gate            Position    `json:"gate"`
+	DestinationGate *Position   `json:"destinationGate,omitempty"`
+}
+
+type JumpDriveNeighbour struct {
+	SolarSystem universe.Id `json:"solarSystem"`
+	Name        string      `json:"name"`
+	Distance    float64     `json:"distance"`
+}
+
+type SystemResponse struct {
+	SolarSystem         universe.Id          `json:"solarSystem"`
+	Name                string               `json:"name"`
+	RegionId            universe.Id          `json:"regionId"`
+	RegionName          string               `json:"regionName"`
+	ConstellationId     universe.Id          `json:"constellationId"`
+	ConstellationName   string               `json:"constellationName,omitempty"`
+	Security            float64              `json:"security"`
+	TrueSecurity        float64              `json:"trueSecurity"`
+	Position            Position             `json:"position"`
+	Gates               []GateNeighbour      `json:"gates"`
+	JumpDriveNeighbours []JumpDriveNeighbour `json:"jumpDriveNeighbours"`
+}
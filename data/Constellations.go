@@ -0,0 +1,10 @@
+package data
+
+import "github.com/dertseha/everoute/universe"
+
+type ConstellationData struct {
+	ConstellationId universe.Id
+	Name            string
+}
+
+var Constellations = []ConstellationData{}
@@ -3,14 +3,17 @@ package main
 import (
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"runtime"
 	"runtime/debug"
 	"strings"
+	"time"
 
 	"github.com/gorilla/rpc"
-	rpcJson "github.com/gorilla/rpc/json"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 
 	"github.com/dertseha/everoute"
 	"github.com/dertseha/everoute/travel/capabilities/jumpdrive"
@@ -20,8 +23,12 @@ import (
 	"github.com/dertseha/everoute/universe"
 
 	"github.com/dertseha/everoute-web/data"
+	"github.com/dertseha/everoute-web/routepb"
 )
 
+// MaxJumpDriveRange is the longest distance, in light years, for which jump drive neighbours are prepared.
+const MaxJumpDriveRange = 10.0
+
 func reachableSystemPredicate() func(data.SolarSystemData) bool {
 	joveRegion := universe.Id(10000017)
 	specialSystems := make(map[universe.Id]interface{})
@@ -123,21 +130,24 @@ func dropUnusedData() {
 	data.SolarSystems = nil
 	data.SolarSystemJumps = nil
 	data.JumpGates = nil
+	data.Regions = nil
+	data.Constellations = nil
 }
 
-func prepareUniverse() *universe.UniverseBuilder {
+func prepareUniverse() (*universe.UniverseBuilder, *SolarSystemCatalog) {
 	builder := universe.New().Extend()
+	catalog := newSolarSystemCatalog(reachableSystemPredicate())
 
 	buildSolarSystems(builder)
 	buildJumpGates(builder)
 	transitcount.ExtendUniverse(builder)
 	security.ExtendUniverse(builder)
 
-	jumpdrive.ExtendUniverse(builder, 10.0)
+	jumpdrive.ExtendUniverse(builder, MaxJumpDriveRange)
 
 	dropUnusedData()
 
-	return builder
+	return builder, catalog
 }
 
 func checkBaseUniverse(verse universe.Universe) {
@@ -165,22 +175,95 @@ func initRuntime() {
 	debug.SetMaxThreads(maxThreads)
 }
 
+func getDurationSetting(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Invalid duration <%s> for %s, using %v", value, key, defaultValue)
+		return defaultValue
+	}
+
+	return duration
+}
+
+func serveGrpc(port string, service *RouteService, universeService *UniverseService) {
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		log.Printf("Failed to listen for gRPC on port <%s>: %v", port, err)
+		return
+	}
+	grpcServer := grpc.NewServer()
+	routepb.RegisterRouteServer(grpcServer, NewRouteGrpcServer(service))
+	routepb.RegisterUniverseServer(grpcServer, NewUniverseGrpcServer(universeService))
+	reflection.Register(grpcServer)
+
+	log.Printf("Starting gRPC server on port <%s>...", port)
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Printf("gRPC server stopped: %v", err)
+	}
+}
+
 func main() {
 	log.Printf("everoute-web v%v using everoute v%v", Version, everoute.Version)
 
 	initRuntime()
 	log.Printf("Building universe...")
-	universeBuilder := prepareUniverse()
+	universeBuilder, catalog := prepareUniverse()
 	universe := universeBuilder.Build()
 	checkBaseUniverse(universe)
 
+	if (len(os.Args) > 1) && (os.Args[1] == "export-graph") {
+		if err := exportUniverseGraph(os.Stdout, universe, catalog, os.Args[2:]); err != nil {
+			log.Printf("Failed to export universe graph: %v", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	log.Printf("Initializing server...")
+	cynoJammers := NewCynoJammerList()
+	if fileName := os.Getenv("CYNO_JAMMERS_FILE"); fileName != "" {
+		if err := cynoJammers.LoadFile(fileName); err != nil {
+			log.Printf("Failed to load cyno jammers from <%s>: %v", fileName, err)
+		}
+	}
+
 	rpcServer := rpc.NewServer()
-	rpcServer.RegisterCodec(rpcJson.NewCodec(), "application/json")
-	service := NewRouteService(universe)
+	rpcServer.RegisterCodec(NewJsonRpc1Codec(), "application/json")
+	rpc2Server := NewJsonRpc2Server(rpcServer)
+	routeJobs := NewRouteJobTable(getDurationSetting("ROUTE_JOB_MAX_RUNTIME", 5*time.Minute),
+		getDurationSetting("ROUTE_JOB_EXPIRY", 15*time.Minute))
+	service := NewRouteService(universe, catalog, cynoJammers, routeJobs)
 	rpcServer.RegisterService(service, "Route")
+	rpc2Server.RegisterService(service, "Route")
+	universeService := NewUniverseService(universe, catalog, cynoJammers)
+	rpcServer.RegisterService(universeService, "Universe")
+	rpc2Server.RegisterService(universeService, "Universe")
+
+	http.Handle("/", rpc2Server)
+	http.Handle("/api/route", NewRouteRestHandler(service, catalog))
+	http.Handle("/api/route/ws", NewRouteWebSocketHandler(service))
+	http.Handle("/api/route/stream", NewRouteEventStreamHandler(service))
+	http.Handle("/api/universe/graph", universeGraphHandler(universe, catalog))
+	http.Handle("/openapi.json", openApiHandler(NewOpenApiDocument()))
+	if adminToken := os.Getenv("ADMIN_TOKEN"); adminToken != "" {
+		adminServer := rpc.NewServer()
+		adminServer.RegisterCodec(NewJsonRpc1Codec(), "application/json")
+		admin2Server := NewJsonRpc2Server(adminServer)
+		cynoJammerService := NewCynoJammerService(universe, cynoJammers)
+		adminServer.RegisterService(cynoJammerService, "CynoJammer")
+		admin2Server.RegisterService(cynoJammerService, "CynoJammer")
+		http.Handle("/admin", adminHandler(admin2Server, adminToken))
+	} else {
+		log.Printf("No ADMIN_TOKEN set, admin interface disabled")
+	}
+	if grpcPort := os.Getenv("GRPC_PORT"); grpcPort != "" {
+		go serveGrpc(grpcPort, service, universeService)
+	}
 
-	http.Handle("/", rpcServer)
 	serverPort := os.Getenv("PORT")
 	if serverPort == "" {
 		serverPort = "3000"
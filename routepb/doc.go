@@ -0,0 +1,5 @@
+// Package routepb contains the protocol buffer messages and gRPC service definitions
+// of the route service. The Go files are generated from route.proto.
+package routepb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative route.proto
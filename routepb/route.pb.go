@@ -0,0 +1,3920 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: route.proto
+
+// Messages of this package correspond to the types of the api package.
+// Solar systems are identified by their ID.
+
+package routepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FromEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SolarSystems    []int64 `protobuf:"varint,1,rep,packed,name=solar_systems,json=solarSystems,proto3" json:"solar_systems,omitempty"`
+	RegionId        int64   `protobuf:"varint,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	ConstellationId int64   `protobuf:"varint,3,opt,name=constellation_id,json=constellationId,proto3" json:"constellation_id,omitempty"`
+}
+
+func (x *FromEntry) Reset() {
+	*x = FromEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FromEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FromEntry) ProtoMessage() {}
+
+func (x *FromEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FromEntry.ProtoReflect.Descriptor instead.
+func (*FromEntry) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FromEntry) GetSolarSystems() []int64 {
+	if x != nil {
+		return x.SolarSystems
+	}
+	return nil
+}
+
+func (x *FromEntry) GetRegionId() int64 {
+	if x != nil {
+		return x.RegionId
+	}
+	return 0
+}
+
+func (x *FromEntry) GetConstellationId() int64 {
+	if x != nil {
+		return x.ConstellationId
+	}
+	return 0
+}
+
+type TravelEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SolarSystem     int64   `protobuf:"varint,1,opt,name=solar_system,json=solarSystem,proto3" json:"solar_system,omitempty"`
+	SolarSystems    []int64 `protobuf:"varint,2,rep,packed,name=solar_systems,json=solarSystems,proto3" json:"solar_systems,omitempty"`
+	RegionId        int64   `protobuf:"varint,3,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	ConstellationId int64   `protobuf:"varint,4,opt,name=constellation_id,json=constellationId,proto3" json:"constellation_id,omitempty"`
+}
+
+func (x *TravelEntry) Reset() {
+	*x = TravelEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TravelEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelEntry) ProtoMessage() {}
+
+func (x *TravelEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelEntry.ProtoReflect.Descriptor instead.
+func (*TravelEntry) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TravelEntry) GetSolarSystem() int64 {
+	if x != nil {
+		return x.SolarSystem
+	}
+	return 0
+}
+
+func (x *TravelEntry) GetSolarSystems() []int64 {
+	if x != nil {
+		return x.SolarSystems
+	}
+	return nil
+}
+
+func (x *TravelEntry) GetRegionId() int64 {
+	if x != nil {
+		return x.RegionId
+	}
+	return 0
+}
+
+func (x *TravelEntry) GetConstellationId() int64 {
+	if x != nil {
+		return x.ConstellationId
+	}
+	return 0
+}
+
+type AvoidEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SolarSystems []int64 `protobuf:"varint,1,rep,packed,name=solar_systems,json=solarSystems,proto3" json:"solar_systems,omitempty"`
+}
+
+func (x *AvoidEntry) Reset() {
+	*x = AvoidEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvoidEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvoidEntry) ProtoMessage() {}
+
+func (x *AvoidEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvoidEntry.ProtoReflect.Descriptor instead.
+func (*AvoidEntry) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AvoidEntry) GetSolarSystems() []int64 {
+	if x != nil {
+		return x.SolarSystems
+	}
+	return nil
+}
+
+type NearestEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecurityClass         string `protobuf:"bytes,1,opt,name=security_class,json=securityClass,proto3" json:"security_class,omitempty"`
+	RegionId              int64  `protobuf:"varint,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	ConstellationId       int64  `protobuf:"varint,3,opt,name=constellation_id,json=constellationId,proto3" json:"constellation_id,omitempty"`
+	AdjacentSecurityClass string `protobuf:"bytes,4,opt,name=adjacent_security_class,json=adjacentSecurityClass,proto3" json:"adjacent_security_class,omitempty"`
+}
+
+func (x *NearestEntry) Reset() {
+	*x = NearestEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearestEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearestEntry) ProtoMessage() {}
+
+func (x *NearestEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearestEntry.ProtoReflect.Descriptor instead.
+func (*NearestEntry) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NearestEntry) GetSecurityClass() string {
+	if x != nil {
+		return x.SecurityClass
+	}
+	return ""
+}
+
+func (x *NearestEntry) GetRegionId() int64 {
+	if x != nil {
+		return x.RegionId
+	}
+	return 0
+}
+
+func (x *NearestEntry) GetConstellationId() int64 {
+	if x != nil {
+		return x.ConstellationId
+	}
+	return 0
+}
+
+func (x *NearestEntry) GetAdjacentSecurityClass() string {
+	if x != nil {
+		return x.AdjacentSecurityClass
+	}
+	return ""
+}
+
+type RouteEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From    *FromEntry     `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Via     []*TravelEntry `protobuf:"bytes,2,rep,name=via,proto3" json:"via,omitempty"`
+	To      *TravelEntry   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Avoid   *AvoidEntry    `protobuf:"bytes,4,opt,name=avoid,proto3" json:"avoid,omitempty"`
+	Nearest *NearestEntry  `protobuf:"bytes,5,opt,name=nearest,proto3" json:"nearest,omitempty"`
+}
+
+func (x *RouteEntry) Reset() {
+	*x = RouteEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteEntry) ProtoMessage() {}
+
+func (x *RouteEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteEntry.ProtoReflect.Descriptor instead.
+func (*RouteEntry) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RouteEntry) GetFrom() *FromEntry {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RouteEntry) GetVia() []*TravelEntry {
+	if x != nil {
+		return x.Via
+	}
+	return nil
+}
+
+func (x *RouteEntry) GetTo() *TravelEntry {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *RouteEntry) GetAvoid() *AvoidEntry {
+	if x != nil {
+		return x.Avoid
+	}
+	return nil
+}
+
+func (x *RouteEntry) GetNearest() *NearestEntry {
+	if x != nil {
+		return x.Nearest
+	}
+	return nil
+}
+
+type SecurityLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min *float64 `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max *float64 `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+}
+
+func (x *SecurityLimits) Reset() {
+	*x = SecurityLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityLimits) ProtoMessage() {}
+
+func (x *SecurityLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityLimits.ProtoReflect.Descriptor instead.
+func (*SecurityLimits) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SecurityLimits) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *SecurityLimits) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+type JumpGateTravelCapability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AvoidHighSec bool            `protobuf:"varint,1,opt,name=avoid_high_sec,json=avoidHighSec,proto3" json:"avoid_high_sec,omitempty"`
+	Security     *SecurityLimits `protobuf:"bytes,2,opt,name=security,proto3" json:"security,omitempty"`
+}
+
+func (x *JumpGateTravelCapability) Reset() {
+	*x = JumpGateTravelCapability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JumpGateTravelCapability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumpGateTravelCapability) ProtoMessage() {}
+
+func (x *JumpGateTravelCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JumpGateTravelCapability.ProtoReflect.Descriptor instead.
+func (*JumpGateTravelCapability) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *JumpGateTravelCapability) GetAvoidHighSec() bool {
+	if x != nil {
+		return x.AvoidHighSec
+	}
+	return false
+}
+
+func (x *JumpGateTravelCapability) GetSecurity() *SecurityLimits {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+type JumpDriveTravelCapability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DistanceLimit float64         `protobuf:"fixed64,1,opt,name=distance_limit,json=distanceLimit,proto3" json:"distance_limit,omitempty"`
+	Security      *SecurityLimits `protobuf:"bytes,2,opt,name=security,proto3" json:"security,omitempty"`
+}
+
+func (x *JumpDriveTravelCapability) Reset() {
+	*x = JumpDriveTravelCapability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JumpDriveTravelCapability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumpDriveTravelCapability) ProtoMessage() {}
+
+func (x *JumpDriveTravelCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JumpDriveTravelCapability.ProtoReflect.Descriptor instead.
+func (*JumpDriveTravelCapability) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *JumpDriveTravelCapability) GetDistanceLimit() float64 {
+	if x != nil {
+		return x.DistanceLimit
+	}
+	return 0
+}
+
+func (x *JumpDriveTravelCapability) GetSecurity() *SecurityLimits {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+type TravelCapabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JumpGate  *JumpGateTravelCapability  `protobuf:"bytes,1,opt,name=jump_gate,json=jumpGate,proto3" json:"jump_gate,omitempty"`
+	JumpDrive *JumpDriveTravelCapability `protobuf:"bytes,2,opt,name=jump_drive,json=jumpDrive,proto3" json:"jump_drive,omitempty"`
+}
+
+func (x *TravelCapabilities) Reset() {
+	*x = TravelCapabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TravelCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelCapabilities) ProtoMessage() {}
+
+func (x *TravelCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelCapabilities.ProtoReflect.Descriptor instead.
+func (*TravelCapabilities) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TravelCapabilities) GetJumpGate() *JumpGateTravelCapability {
+	if x != nil {
+		return x.JumpGate
+	}
+	return nil
+}
+
+func (x *TravelCapabilities) GetJumpDrive() *JumpDriveTravelCapability {
+	if x != nil {
+		return x.JumpDrive
+	}
+	return nil
+}
+
+type TravelRuleParameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Priority uint32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *TravelRuleParameter) Reset() {
+	*x = TravelRuleParameter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TravelRuleParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelRuleParameter) ProtoMessage() {}
+
+func (x *TravelRuleParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelRuleParameter.ProtoReflect.Descriptor instead.
+func (*TravelRuleParameter) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TravelRuleParameter) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type SecurityTravelRuleParameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Priority uint32  `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Limit    float64 `protobuf:"fixed64,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SecurityTravelRuleParameter) Reset() {
+	*x = SecurityTravelRuleParameter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityTravelRuleParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityTravelRuleParameter) ProtoMessage() {}
+
+func (x *SecurityTravelRuleParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityTravelRuleParameter.ProtoReflect.Descriptor instead.
+func (*SecurityTravelRuleParameter) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SecurityTravelRuleParameter) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SecurityTravelRuleParameter) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TravelRuleset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransitCount *TravelRuleParameter         `protobuf:"bytes,1,opt,name=transit_count,json=transitCount,proto3" json:"transit_count,omitempty"`
+	MinSecurity  *SecurityTravelRuleParameter `protobuf:"bytes,2,opt,name=min_security,json=minSecurity,proto3" json:"min_security,omitempty"`
+	MaxSecurity  *SecurityTravelRuleParameter `protobuf:"bytes,3,opt,name=max_security,json=maxSecurity,proto3" json:"max_security,omitempty"`
+	JumpDistance *TravelRuleParameter         `protobuf:"bytes,4,opt,name=jump_distance,json=jumpDistance,proto3" json:"jump_distance,omitempty"`
+	WarpDistance *TravelRuleParameter         `protobuf:"bytes,5,opt,name=warp_distance,json=warpDistance,proto3" json:"warp_distance,omitempty"`
+}
+
+func (x *TravelRuleset) Reset() {
+	*x = TravelRuleset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TravelRuleset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelRuleset) ProtoMessage() {}
+
+func (x *TravelRuleset) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelRuleset.ProtoReflect.Descriptor instead.
+func (*TravelRuleset) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TravelRuleset) GetTransitCount() *TravelRuleParameter {
+	if x != nil {
+		return x.TransitCount
+	}
+	return nil
+}
+
+func (x *TravelRuleset) GetMinSecurity() *SecurityTravelRuleParameter {
+	if x != nil {
+		return x.MinSecurity
+	}
+	return nil
+}
+
+func (x *TravelRuleset) GetMaxSecurity() *SecurityTravelRuleParameter {
+	if x != nil {
+		return x.MaxSecurity
+	}
+	return nil
+}
+
+func (x *TravelRuleset) GetJumpDistance() *TravelRuleParameter {
+	if x != nil {
+		return x.JumpDistance
+	}
+	return nil
+}
+
+func (x *TravelRuleset) GetWarpDistance() *TravelRuleParameter {
+	if x != nil {
+		return x.WarpDistance
+	}
+	return nil
+}
+
+type RouteFindRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Route              *RouteEntry         `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Capabilities       *TravelCapabilities `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Rules              *TravelRuleset      `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules,omitempty"`
+	Alternatives       uint32              `protobuf:"varint,4,opt,name=alternatives,proto3" json:"alternatives,omitempty"`
+	AlternativeOverlap *float64            `protobuf:"fixed64,5,opt,name=alternative_overlap,json=alternativeOverlap,proto3,oneof" json:"alternative_overlap,omitempty"`
+	Details            bool                `protobuf:"varint,6,opt,name=details,proto3" json:"details,omitempty"`
+	OptimizeVia        string              `protobuf:"bytes,7,opt,name=optimize_via,json=optimizeVia,proto3" json:"optimize_via,omitempty"`
+}
+
+func (x *RouteFindRequest) Reset() {
+	*x = RouteFindRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteFindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteFindRequest) ProtoMessage() {}
+
+func (x *RouteFindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteFindRequest.ProtoReflect.Descriptor instead.
+func (*RouteFindRequest) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RouteFindRequest) GetRoute() *RouteEntry {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *RouteFindRequest) GetCapabilities() *TravelCapabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *RouteFindRequest) GetRules() *TravelRuleset {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *RouteFindRequest) GetAlternatives() uint32 {
+	if x != nil {
+		return x.Alternatives
+	}
+	return 0
+}
+
+func (x *RouteFindRequest) GetAlternativeOverlap() float64 {
+	if x != nil && x.AlternativeOverlap != nil {
+		return *x.AlternativeOverlap
+	}
+	return 0
+}
+
+func (x *RouteFindRequest) GetDetails() bool {
+	if x != nil {
+		return x.Details
+	}
+	return false
+}
+
+func (x *RouteFindRequest) GetOptimizeVia() string {
+	if x != nil {
+		return x.OptimizeVia
+	}
+	return ""
+}
+
+type Stargate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Position *Position `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *Stargate) Reset() {
+	*x = Stargate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stargate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stargate) ProtoMessage() {}
+
+func (x *Stargate) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stargate.ProtoReflect.Descriptor instead.
+func (*Stargate) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Stargate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Stargate) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type PathVia struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     string    `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Stargate *Stargate `protobuf:"bytes,2,opt,name=stargate,proto3" json:"stargate,omitempty"`
+}
+
+func (x *PathVia) Reset() {
+	*x = PathVia{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathVia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathVia) ProtoMessage() {}
+
+func (x *PathVia) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathVia.ProtoReflect.Descriptor instead.
+func (*PathVia) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PathVia) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PathVia) GetStargate() *Stargate {
+	if x != nil {
+		return x.Stargate
+	}
+	return nil
+}
+
+type PathEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SolarSystem       int64       `protobuf:"varint,1,opt,name=solar_system,json=solarSystem,proto3" json:"solar_system,omitempty"`
+	JumpDistance      *float64    `protobuf:"fixed64,2,opt,name=jump_distance,json=jumpDistance,proto3,oneof" json:"jump_distance,omitempty"`
+	WarpDistance      *float64    `protobuf:"fixed64,3,opt,name=warp_distance,json=warpDistance,proto3,oneof" json:"warp_distance,omitempty"`
+	Name              string      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	ConstellationId   int64       `protobuf:"varint,5,opt,name=constellation_id,json=constellationId,proto3" json:"constellation_id,omitempty"`
+	RegionId          int64       `protobuf:"varint,6,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	RegionName        string      `protobuf:"bytes,7,opt,name=region_name,json=regionName,proto3" json:"region_name,omitempty"`
+	Security          *float64    `protobuf:"fixed64,8,opt,name=security,proto3,oneof" json:"security,omitempty"`
+	TrueSecurity      *float64    `protobuf:"fixed64,9,opt,name=true_security,json=trueSecurity,proto3,oneof" json:"true_security,omitempty"`
+	SecurityClass     string      `protobuf:"bytes,10,opt,name=security_class,json=securityClass,proto3" json:"security_class,omitempty"`
+	Via               *PathVia    `protobuf:"bytes,11,opt,name=via,proto3" json:"via,omitempty"`
+	Costs             []*RuleCost `protobuf:"bytes,12,rep,name=costs,proto3" json:"costs,omitempty"`
+	ConstellationName string      `protobuf:"bytes,13,opt,name=constellation_name,json=constellationName,proto3" json:"constellation_name,omitempty"`
+}
+
+func (x *PathEntry) Reset() {
+	*x = PathEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathEntry) ProtoMessage() {}
+
+func (x *PathEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathEntry.ProtoReflect.Descriptor instead.
+func (*PathEntry) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PathEntry) GetSolarSystem() int64 {
+	if x != nil {
+		return x.SolarSystem
+	}
+	return 0
+}
+
+func (x *PathEntry) GetJumpDistance() float64 {
+	if x != nil && x.JumpDistance != nil {
+		return *x.JumpDistance
+	}
+	return 0
+}
+
+func (x *PathEntry) GetWarpDistance() float64 {
+	if x != nil && x.WarpDistance != nil {
+		return *x.WarpDistance
+	}
+	return 0
+}
+
+func (x *PathEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PathEntry) GetConstellationId() int64 {
+	if x != nil {
+		return x.ConstellationId
+	}
+	return 0
+}
+
+func (x *PathEntry) GetRegionId() int64 {
+	if x != nil {
+		return x.RegionId
+	}
+	return 0
+}
+
+func (x *PathEntry) GetRegionName() string {
+	if x != nil {
+		return x.RegionName
+	}
+	return ""
+}
+
+func (x *PathEntry) GetSecurity() float64 {
+	if x != nil && x.Security != nil {
+		return *x.Security
+	}
+	return 0
+}
+
+func (x *PathEntry) GetTrueSecurity() float64 {
+	if x != nil && x.TrueSecurity != nil {
+		return *x.TrueSecurity
+	}
+	return 0
+}
+
+func (x *PathEntry) GetSecurityClass() string {
+	if x != nil {
+		return x.SecurityClass
+	}
+	return ""
+}
+
+func (x *PathEntry) GetVia() *PathVia {
+	if x != nil {
+		return x.Via
+	}
+	return nil
+}
+
+func (x *PathEntry) GetCosts() []*RuleCost {
+	if x != nil {
+		return x.Costs
+	}
+	return nil
+}
+
+func (x *PathEntry) GetConstellationName() string {
+	if x != nil {
+		return x.ConstellationName
+	}
+	return ""
+}
+
+type RouteNotice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         string  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Message      string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	SolarSystems []int64 `protobuf:"varint,3,rep,packed,name=solar_systems,json=solarSystems,proto3" json:"solar_systems,omitempty"`
+}
+
+func (x *RouteNotice) Reset() {
+	*x = RouteNotice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteNotice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteNotice) ProtoMessage() {}
+
+func (x *RouteNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteNotice.ProtoReflect.Descriptor instead.
+func (*RouteNotice) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RouteNotice) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RouteNotice) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RouteNotice) GetSolarSystems() []int64 {
+	if x != nil {
+		return x.SolarSystems
+	}
+	return nil
+}
+
+type RuleCost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule  string  `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *RuleCost) Reset() {
+	*x = RuleCost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleCost) ProtoMessage() {}
+
+func (x *RuleCost) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleCost.ProtoReflect.Descriptor instead.
+func (*RuleCost) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RuleCost) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RuleCost) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type RouteAlternative struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path    []*PathEntry  `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	Costs   []*RuleCost   `protobuf:"bytes,2,rep,name=costs,proto3" json:"costs,omitempty"`
+	Summary *RouteSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *RouteAlternative) Reset() {
+	*x = RouteAlternative{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteAlternative) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteAlternative) ProtoMessage() {}
+
+func (x *RouteAlternative) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteAlternative.ProtoReflect.Descriptor instead.
+func (*RouteAlternative) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RouteAlternative) GetPath() []*PathEntry {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *RouteAlternative) GetCosts() []*RuleCost {
+	if x != nil {
+		return x.Costs
+	}
+	return nil
+}
+
+func (x *RouteAlternative) GetSummary() *RouteSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type RouteSummaryRegion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RegionId   int64  `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	RegionName string `protobuf:"bytes,2,opt,name=region_name,json=regionName,proto3" json:"region_name,omitempty"`
+}
+
+func (x *RouteSummaryRegion) Reset() {
+	*x = RouteSummaryRegion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteSummaryRegion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteSummaryRegion) ProtoMessage() {}
+
+func (x *RouteSummaryRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteSummaryRegion.ProtoReflect.Descriptor instead.
+func (*RouteSummaryRegion) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RouteSummaryRegion) GetRegionId() int64 {
+	if x != nil {
+		return x.RegionId
+	}
+	return 0
+}
+
+func (x *RouteSummaryRegion) GetRegionName() string {
+	if x != nil {
+		return x.RegionName
+	}
+	return ""
+}
+
+type RouteSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jumps          map[string]uint32     `protobuf:"bytes,1,rep,name=jumps,proto3" json:"jumps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	JumpDistance   float64               `protobuf:"fixed64,2,opt,name=jump_distance,json=jumpDistance,proto3" json:"jump_distance,omitempty"`
+	WarpDistance   float64               `protobuf:"fixed64,3,opt,name=warp_distance,json=warpDistance,proto3" json:"warp_distance,omitempty"`
+	MinSecurity    float64               `protobuf:"fixed64,4,opt,name=min_security,json=minSecurity,proto3" json:"min_security,omitempty"`
+	MaxSecurity    float64               `protobuf:"fixed64,5,opt,name=max_security,json=maxSecurity,proto3" json:"max_security,omitempty"`
+	HighSecSystems uint32                `protobuf:"varint,6,opt,name=high_sec_systems,json=highSecSystems,proto3" json:"high_sec_systems,omitempty"`
+	LowSecSystems  uint32                `protobuf:"varint,7,opt,name=low_sec_systems,json=lowSecSystems,proto3" json:"low_sec_systems,omitempty"`
+	NullSecSystems uint32                `protobuf:"varint,8,opt,name=null_sec_systems,json=nullSecSystems,proto3" json:"null_sec_systems,omitempty"`
+	Regions        []*RouteSummaryRegion `protobuf:"bytes,9,rep,name=regions,proto3" json:"regions,omitempty"`
+}
+
+func (x *RouteSummary) Reset() {
+	*x = RouteSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteSummary) ProtoMessage() {}
+
+func (x *RouteSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteSummary.ProtoReflect.Descriptor instead.
+func (*RouteSummary) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RouteSummary) GetJumps() map[string]uint32 {
+	if x != nil {
+		return x.Jumps
+	}
+	return nil
+}
+
+func (x *RouteSummary) GetJumpDistance() float64 {
+	if x != nil {
+		return x.JumpDistance
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetWarpDistance() float64 {
+	if x != nil {
+		return x.WarpDistance
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetMinSecurity() float64 {
+	if x != nil {
+		return x.MinSecurity
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetMaxSecurity() float64 {
+	if x != nil {
+		return x.MaxSecurity
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetHighSecSystems() uint32 {
+	if x != nil {
+		return x.HighSecSystems
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetLowSecSystems() uint32 {
+	if x != nil {
+		return x.LowSecSystems
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetNullSecSystems() uint32 {
+	if x != nil {
+		return x.NullSecSystems
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetRegions() []*RouteSummaryRegion {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type RouteSearchStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State        string  `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Reason       string  `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Elapsed      float64 `protobuf:"fixed64,3,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+	Optimal      bool    `protobuf:"varint,4,opt,name=optimal,proto3" json:"optimal,omitempty"`
+	Improvements int32   `protobuf:"varint,5,opt,name=improvements,proto3" json:"improvements,omitempty"`
+}
+
+func (x *RouteSearchStatus) Reset() {
+	*x = RouteSearchStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteSearchStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteSearchStatus) ProtoMessage() {}
+
+func (x *RouteSearchStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteSearchStatus.ProtoReflect.Descriptor instead.
+func (*RouteSearchStatus) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RouteSearchStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RouteSearchStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RouteSearchStatus) GetElapsed() float64 {
+	if x != nil {
+		return x.Elapsed
+	}
+	return 0
+}
+
+func (x *RouteSearchStatus) GetOptimal() bool {
+	if x != nil {
+		return x.Optimal
+	}
+	return false
+}
+
+func (x *RouteSearchStatus) GetImprovements() int32 {
+	if x != nil {
+		return x.Improvements
+	}
+	return 0
+}
+
+type RouteFindResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path          []*PathEntry        `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	Notices       []*RouteNotice      `protobuf:"bytes,2,rep,name=notices,proto3" json:"notices,omitempty"`
+	Alternatives  []*RouteAlternative `protobuf:"bytes,3,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	Summary       *RouteSummary       `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Costs         []*RuleCost         `protobuf:"bytes,5,rep,name=costs,proto3" json:"costs,omitempty"`
+	Status        *RouteSearchStatus  `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	WaypointOrder []int64             `protobuf:"varint,7,rep,packed,name=waypoint_order,json=waypointOrder,proto3" json:"waypoint_order,omitempty"`
+	Start         int64               `protobuf:"varint,8,opt,name=start,proto3" json:"start,omitempty"`
+	Destination   int64               `protobuf:"varint,9,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (x *RouteFindResponse) Reset() {
+	*x = RouteFindResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteFindResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteFindResponse) ProtoMessage() {}
+
+func (x *RouteFindResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteFindResponse.ProtoReflect.Descriptor instead.
+func (*RouteFindResponse) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RouteFindResponse) GetPath() []*PathEntry {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *RouteFindResponse) GetNotices() []*RouteNotice {
+	if x != nil {
+		return x.Notices
+	}
+	return nil
+}
+
+func (x *RouteFindResponse) GetAlternatives() []*RouteAlternative {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+func (x *RouteFindResponse) GetSummary() *RouteSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *RouteFindResponse) GetCosts() []*RuleCost {
+	if x != nil {
+		return x.Costs
+	}
+	return nil
+}
+
+func (x *RouteFindResponse) GetStatus() *RouteSearchStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *RouteFindResponse) GetWaypointOrder() []int64 {
+	if x != nil {
+		return x.WaypointOrder
+	}
+	return nil
+}
+
+func (x *RouteFindResponse) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *RouteFindResponse) GetDestination() int64 {
+	if x != nil {
+		return x.Destination
+	}
+	return 0
+}
+
+type RouteMatrixRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Origins          []int64             `protobuf:"varint,1,rep,packed,name=origins,proto3" json:"origins,omitempty"`
+	Destinations     []int64             `protobuf:"varint,2,rep,packed,name=destinations,proto3" json:"destinations,omitempty"`
+	Avoid            *AvoidEntry         `protobuf:"bytes,3,opt,name=avoid,proto3" json:"avoid,omitempty"`
+	Capabilities     *TravelCapabilities `protobuf:"bytes,4,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Rules            *TravelRuleset      `protobuf:"bytes,5,opt,name=rules,proto3" json:"rules,omitempty"`
+	IncludeDistances bool                `protobuf:"varint,6,opt,name=include_distances,json=includeDistances,proto3" json:"include_distances,omitempty"`
+}
+
+func (x *RouteMatrixRequest) Reset() {
+	*x = RouteMatrixRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteMatrixRequest) ProtoMessage() {}
+
+func (x *RouteMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteMatrixRequest.ProtoReflect.Descriptor instead.
+func (*RouteMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RouteMatrixRequest) GetOrigins() []int64 {
+	if x != nil {
+		return x.Origins
+	}
+	return nil
+}
+
+func (x *RouteMatrixRequest) GetDestinations() []int64 {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *RouteMatrixRequest) GetAvoid() *AvoidEntry {
+	if x != nil {
+		return x.Avoid
+	}
+	return nil
+}
+
+func (x *RouteMatrixRequest) GetCapabilities() *TravelCapabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *RouteMatrixRequest) GetRules() *TravelRuleset {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *RouteMatrixRequest) GetIncludeDistances() bool {
+	if x != nil {
+		return x.IncludeDistances
+	}
+	return false
+}
+
+type RouteMatrixEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination  int64    `protobuf:"varint,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Reachable    bool     `protobuf:"varint,2,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	Jumps        int32    `protobuf:"varint,3,opt,name=jumps,proto3" json:"jumps,omitempty"`
+	JumpDistance *float64 `protobuf:"fixed64,4,opt,name=jump_distance,json=jumpDistance,proto3,oneof" json:"jump_distance,omitempty"`
+	WarpDistance *float64 `protobuf:"fixed64,5,opt,name=warp_distance,json=warpDistance,proto3,oneof" json:"warp_distance,omitempty"`
+}
+
+func (x *RouteMatrixEntry) Reset() {
+	*x = RouteMatrixEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteMatrixEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteMatrixEntry) ProtoMessage() {}
+
+func (x *RouteMatrixEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteMatrixEntry.ProtoReflect.Descriptor instead.
+func (*RouteMatrixEntry) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RouteMatrixEntry) GetDestination() int64 {
+	if x != nil {
+		return x.Destination
+	}
+	return 0
+}
+
+func (x *RouteMatrixEntry) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *RouteMatrixEntry) GetJumps() int32 {
+	if x != nil {
+		return x.Jumps
+	}
+	return 0
+}
+
+func (x *RouteMatrixEntry) GetJumpDistance() float64 {
+	if x != nil && x.JumpDistance != nil {
+		return *x.JumpDistance
+	}
+	return 0
+}
+
+func (x *RouteMatrixEntry) GetWarpDistance() float64 {
+	if x != nil && x.WarpDistance != nil {
+		return *x.WarpDistance
+	}
+	return 0
+}
+
+type RouteMatrixRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Origin  int64               `protobuf:"varint,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	Entries []*RouteMatrixEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *RouteMatrixRow) Reset() {
+	*x = RouteMatrixRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteMatrixRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteMatrixRow) ProtoMessage() {}
+
+func (x *RouteMatrixRow) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteMatrixRow.ProtoReflect.Descriptor instead.
+func (*RouteMatrixRow) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RouteMatrixRow) GetOrigin() int64 {
+	if x != nil {
+		return x.Origin
+	}
+	return 0
+}
+
+func (x *RouteMatrixRow) GetEntries() []*RouteMatrixEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type RouteMatrixResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows []*RouteMatrixRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *RouteMatrixResponse) Reset() {
+	*x = RouteMatrixResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteMatrixResponse) ProtoMessage() {}
+
+func (x *RouteMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteMatrixResponse.ProtoReflect.Descriptor instead.
+func (*RouteMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RouteMatrixResponse) GetRows() []*RouteMatrixRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type RouteReachableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From         *FromEntry          `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	MaxJumps     uint32              `protobuf:"varint,2,opt,name=max_jumps,json=maxJumps,proto3" json:"max_jumps,omitempty"`
+	Avoid        *AvoidEntry         `protobuf:"bytes,3,opt,name=avoid,proto3" json:"avoid,omitempty"`
+	Capabilities *TravelCapabilities `protobuf:"bytes,4,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Rules        *TravelRuleset      `protobuf:"bytes,5,opt,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *RouteReachableRequest) Reset() {
+	*x = RouteReachableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteReachableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteReachableRequest) ProtoMessage() {}
+
+func (x *RouteReachableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteReachableRequest.ProtoReflect.Descriptor instead.
+func (*RouteReachableRequest) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RouteReachableRequest) GetFrom() *FromEntry {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RouteReachableRequest) GetMaxJumps() uint32 {
+	if x != nil {
+		return x.MaxJumps
+	}
+	return 0
+}
+
+func (x *RouteReachableRequest) GetAvoid() *AvoidEntry {
+	if x != nil {
+		return x.Avoid
+	}
+	return nil
+}
+
+func (x *RouteReachableRequest) GetCapabilities() *TravelCapabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *RouteReachableRequest) GetRules() *TravelRuleset {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ReachableSystem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SolarSystem  int64       `protobuf:"varint,1,opt,name=solar_system,json=solarSystem,proto3" json:"solar_system,omitempty"`
+	Jumps        int32       `protobuf:"varint,2,opt,name=jumps,proto3" json:"jumps,omitempty"`
+	JumpDistance float64     `protobuf:"fixed64,3,opt,name=jump_distance,json=jumpDistance,proto3" json:"jump_distance,omitempty"`
+	WarpDistance float64     `protobuf:"fixed64,4,opt,name=warp_distance,json=warpDistance,proto3" json:"warp_distance,omitempty"`
+	Costs        []*RuleCost `protobuf:"bytes,5,rep,name=costs,proto3" json:"costs,omitempty"`
+}
+
+func (x *ReachableSystem) Reset() {
+	*x = ReachableSystem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReachableSystem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReachableSystem) ProtoMessage() {}
+
+func (x *ReachableSystem) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReachableSystem.ProtoReflect.Descriptor instead.
+func (*ReachableSystem) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReachableSystem) GetSolarSystem() int64 {
+	if x != nil {
+		return x.SolarSystem
+	}
+	return 0
+}
+
+func (x *ReachableSystem) GetJumps() int32 {
+	if x != nil {
+		return x.Jumps
+	}
+	return 0
+}
+
+func (x *ReachableSystem) GetJumpDistance() float64 {
+	if x != nil {
+		return x.JumpDistance
+	}
+	return 0
+}
+
+func (x *ReachableSystem) GetWarpDistance() float64 {
+	if x != nil {
+		return x.WarpDistance
+	}
+	return 0
+}
+
+func (x *ReachableSystem) GetCosts() []*RuleCost {
+	if x != nil {
+		return x.Costs
+	}
+	return nil
+}
+
+type RouteReachableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Systems []*ReachableSystem `protobuf:"bytes,1,rep,name=systems,proto3" json:"systems,omitempty"`
+}
+
+func (x *RouteReachableResponse) Reset() {
+	*x = RouteReachableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteReachableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteReachableResponse) ProtoMessage() {}
+
+func (x *RouteReachableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteReachableResponse.ProtoReflect.Descriptor instead.
+func (*RouteReachableResponse) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RouteReachableResponse) GetSystems() []*ReachableSystem {
+	if x != nil {
+		return x.Systems
+	}
+	return nil
+}
+
+type ErrorProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Field   string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (x *ErrorProblem) Reset() {
+	*x = ErrorProblem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorProblem) ProtoMessage() {}
+
+func (x *ErrorProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorProblem.ProtoReflect.Descriptor instead.
+func (*ErrorProblem) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ErrorProblem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ErrorProblem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ErrorProblem) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+// ErrorResponse is attached as detail to the status of failed calls.
+type ErrorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error    string          `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Code     string          `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Problems []*ErrorProblem `protobuf:"bytes,3,rep,name=problems,proto3" json:"problems,omitempty"`
+}
+
+func (x *ErrorResponse) Reset() {
+	*x = ErrorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorResponse) ProtoMessage() {}
+
+func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
+func (*ErrorResponse) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ErrorResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ErrorResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ErrorResponse) GetProblems() []*ErrorProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+type InRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SolarSystem int64   `protobuf:"varint,1,opt,name=solar_system,json=solarSystem,proto3" json:"solar_system,omitempty"`
+	Range       float64 `protobuf:"fixed64,2,opt,name=range,proto3" json:"range,omitempty"`
+}
+
+func (x *InRangeRequest) Reset() {
+	*x = InRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InRangeRequest) ProtoMessage() {}
+
+func (x *InRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InRangeRequest.ProtoReflect.Descriptor instead.
+func (*InRangeRequest) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InRangeRequest) GetSolarSystem() int64 {
+	if x != nil {
+		return x.SolarSystem
+	}
+	return 0
+}
+
+func (x *InRangeRequest) GetRange() float64 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+type InRangeSystem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SolarSystem  int64   `protobuf:"varint,1,opt,name=solar_system,json=solarSystem,proto3" json:"solar_system,omitempty"`
+	Name         string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Distance     float64 `protobuf:"fixed64,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	Security     float64 `protobuf:"fixed64,4,opt,name=security,proto3" json:"security,omitempty"`
+	TrueSecurity float64 `protobuf:"fixed64,5,opt,name=true_security,json=trueSecurity,proto3" json:"true_security,omitempty"`
+	CynoTarget   bool    `protobuf:"varint,6,opt,name=cyno_target,json=cynoTarget,proto3" json:"cyno_target,omitempty"`
+}
+
+func (x *InRangeSystem) Reset() {
+	*x = InRangeSystem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InRangeSystem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InRangeSystem) ProtoMessage() {}
+
+func (x *InRangeSystem) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InRangeSystem.ProtoReflect.Descriptor instead.
+func (*InRangeSystem) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InRangeSystem) GetSolarSystem() int64 {
+	if x != nil {
+		return x.SolarSystem
+	}
+	return 0
+}
+
+func (x *InRangeSystem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InRangeSystem) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *InRangeSystem) GetSecurity() float64 {
+	if x != nil {
+		return x.Security
+	}
+	return 0
+}
+
+func (x *InRangeSystem) GetTrueSecurity() float64 {
+	if x != nil {
+		return x.TrueSecurity
+	}
+	return 0
+}
+
+func (x *InRangeSystem) GetCynoTarget() bool {
+	if x != nil {
+		return x.CynoTarget
+	}
+	return false
+}
+
+type InRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Systems []*InRangeSystem `protobuf:"bytes,1,rep,name=systems,proto3" json:"systems,omitempty"`
+}
+
+func (x *InRangeResponse) Reset() {
+	*x = InRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InRangeResponse) ProtoMessage() {}
+
+func (x *InRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InRangeResponse.ProtoReflect.Descriptor instead.
+func (*InRangeResponse) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InRangeResponse) GetSystems() []*InRangeSystem {
+	if x != nil {
+		return x.Systems
+	}
+	return nil
+}
+
+type SystemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SolarSystem int64  `protobuf:"varint,1,opt,name=solar_system,json=solarSystem,proto3" json:"solar_system,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SystemRequest) Reset() {
+	*x = SystemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRequest) ProtoMessage() {}
+
+func (x *SystemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRequest.ProtoReflect.Descriptor instead.
+func (*SystemRequest) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SystemRequest) GetSolarSystem() int64 {
+	if x != nil {
+		return x.SolarSystem
+	}
+	return 0
+}
+
+func (x *SystemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Position struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float64 `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float64 `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z float64 `protobuf:"fixed64,3,opt,name=z,proto3" json:"z,omitempty"`
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Position) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Position) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Position) GetZ() float64 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+type GateNeighbour struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SolarSystem     int64     `protobuf:"varint,1,opt,name=solar_system,json=solarSystem,proto3" json:"solar_system,omitempty"`
+	Name            string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Gate            *Position `protobuf:"bytes,3,opt,name=gate,proto3" json:"gate,omitempty"`
+	DestinationGate *Position `protobuf:"bytes,4,opt,name=destination_gate,json=destinationGate,proto3" json:"destination_gate,omitempty"`
+}
+
+func (x *GateNeighbour) Reset() {
+	*x = GateNeighbour{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GateNeighbour) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GateNeighbour) ProtoMessage() {}
+
+func (x *GateNeighbour) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GateNeighbour.ProtoReflect.Descriptor instead.
+func (*GateNeighbour) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GateNeighbour) GetSolarSystem() int64 {
+	if x != nil {
+		return x.SolarSystem
+	}
+	return 0
+}
+
+func (x *GateNeighbour) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GateNeighbour) GetGate() *Position {
+	if x != nil {
+		return x.Gate
+	}
+	return nil
+}
+
+func (x *GateNeighbour) GetDestinationGate() *Position {
+	if x != nil {
+		return x.DestinationGate
+	}
+	return nil
+}
+
+type JumpDriveNeighbour struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SolarSystem int64   `protobuf:"varint,1,opt,name=solar_system,json=solarSystem,proto3" json:"solar_system,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Distance    float64 `protobuf:"fixed64,3,opt,name=distance,proto3" json:"distance,omitempty"`
+}
+
+func (x *JumpDriveNeighbour) Reset() {
+	*x = JumpDriveNeighbour{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JumpDriveNeighbour) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumpDriveNeighbour) ProtoMessage() {}
+
+func (x *JumpDriveNeighbour) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JumpDriveNeighbour.ProtoReflect.Descriptor instead.
+func (*JumpDriveNeighbour) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *JumpDriveNeighbour) GetSolarSystem() int64 {
+	if x != nil {
+		return x.SolarSystem
+	}
+	return 0
+}
+
+func (x *JumpDriveNeighbour) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JumpDriveNeighbour) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type SystemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SolarSystem         int64                 `protobuf:"varint,1,opt,name=solar_system,json=solarSystem,proto3" json:"solar_system,omitempty"`
+	Name                string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RegionId            int64                 `protobuf:"varint,3,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	RegionName          string                `protobuf:"bytes,4,opt,name=region_name,json=regionName,proto3" json:"region_name,omitempty"`
+	ConstellationId     int64                 `protobuf:"varint,5,opt,name=constellation_id,json=constellationId,proto3" json:"constellation_id,omitempty"`
+	Security            float64               `protobuf:"fixed64,6,opt,name=security,proto3" json:"security,omitempty"`
+	TrueSecurity        float64               `protobuf:"fixed64,7,opt,name=true_security,json=trueSecurity,proto3" json:"true_security,omitempty"`
+	Position            *Position             `protobuf:"bytes,8,opt,name=position,proto3" json:"position,omitempty"`
+	Gates               []*GateNeighbour      `protobuf:"bytes,9,rep,name=gates,proto3" json:"gates,omitempty"`
+	JumpDriveNeighbours []*JumpDriveNeighbour `protobuf:"bytes,10,rep,name=jump_drive_neighbours,json=jumpDriveNeighbours,proto3" json:"jump_drive_neighbours,omitempty"`
+	ConstellationName   string                `protobuf:"bytes,11,opt,name=constellation_name,json=constellationName,proto3" json:"constellation_name,omitempty"`
+}
+
+func (x *SystemResponse) Reset() {
+	*x = SystemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_route_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemResponse) ProtoMessage() {}
+
+func (x *SystemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_route_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemResponse.ProtoReflect.Descriptor instead.
+func (*SystemResponse) Descriptor() ([]byte, []int) {
+	return file_route_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SystemResponse) GetSolarSystem() int64 {
+	if x != nil {
+		return x.SolarSystem
+	}
+	return 0
+}
+
+func (x *SystemResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemResponse) GetRegionId() int64 {
+	if x != nil {
+		return x.RegionId
+	}
+	return 0
+}
+
+func (x *SystemResponse) GetRegionName() string {
+	if x != nil {
+		return x.RegionName
+	}
+	return ""
+}
+
+func (x *SystemResponse) GetConstellationId() int64 {
+	if x != nil {
+		return x.ConstellationId
+	}
+	return 0
+}
+
+func (x *SystemResponse) GetSecurity() float64 {
+	if x != nil {
+		return x.Security
+	}
+	return 0
+}
+
+func (x *SystemResponse) GetTrueSecurity() float64 {
+	if x != nil {
+		return x.TrueSecurity
+	}
+	return 0
+}
+
+func (x *SystemResponse) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *SystemResponse) GetGates() []*GateNeighbour {
+	if x != nil {
+		return x.Gates
+	}
+	return nil
+}
+
+func (x *SystemResponse) GetJumpDriveNeighbours() []*JumpDriveNeighbour {
+	if x != nil {
+		return x.JumpDriveNeighbours
+	}
+	return nil
+}
+
+func (x *SystemResponse) GetConstellationName() string {
+	if x != nil {
+		return x.ConstellationName
+	}
+	return ""
+}
+
+var File_route_proto protoreflect.FileDescriptor
+
+var file_route_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x65,
+	0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x22, 0x78, 0x0a, 0x09, 0x46,
+	0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x6c, 0x61,
+	0x72, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x0c, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x6f, 0x6c,
+	0x61, 0x72, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x6c, 0x61,
+	0x72, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x0c, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x0a, 0x41, 0x76, 0x6f, 0x69, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x6f, 0x6c, 0x61,
+	0x72, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x0c, 0x4e, 0x65, 0x61,
+	0x72, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x64, 0x6a, 0x61,
+	0x63, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x64, 0x6a, 0x61, 0x63,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x22, 0xf7, 0x01, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x2b, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x46, 0x72, 0x6f,
+	0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2b, 0x0a, 0x03,
+	0x76, 0x69, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x76, 0x69, 0x61, 0x12, 0x29, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77,
+	0x65, 0x62, 0x2e, 0x41, 0x76, 0x6f, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61,
+	0x76, 0x6f, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x6e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x6e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x0e, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x15, 0x0a, 0x03,
+	0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x69, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d,
+	0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0x7a, 0x0a, 0x18, 0x4a, 0x75,
+	0x6d, 0x70, 0x47, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x5f,
+	0x68, 0x69, 0x67, 0x68, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x61, 0x76, 0x6f, 0x69, 0x64, 0x48, 0x69, 0x67, 0x68, 0x53, 0x65, 0x63, 0x12, 0x38, 0x0a, 0x08,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x08, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x22, 0x7c, 0x0a, 0x19, 0x4a, 0x75, 0x6d, 0x70, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65,
+	0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0xa1, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x6a,
+	0x75, 0x6d, 0x70, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x4a, 0x75,
+	0x6d, 0x70, 0x47, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x08, 0x6a, 0x75, 0x6d, 0x70, 0x47, 0x61, 0x74, 0x65,
+	0x12, 0x46, 0x0a, 0x0a, 0x6a, 0x75, 0x6d, 0x70, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x77, 0x65, 0x62, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x44, 0x72, 0x69, 0x76, 0x65, 0x54, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x09, 0x6a,
+	0x75, 0x6d, 0x70, 0x44, 0x72, 0x69, 0x76, 0x65, 0x22, 0x31, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x76,
+	0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x4f, 0x0a, 0x1b, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x52, 0x75, 0x6c,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x83, 0x03, 0x0a,
+	0x0d, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x46,
+	0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65,
+	0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x76, 0x65,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x46, 0x0a, 0x0d, 0x6a, 0x75, 0x6d, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x52,
+	0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x6a, 0x75,
+	0x6d, 0x70, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x77, 0x61,
+	0x72, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x77, 0x61, 0x72, 0x70, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0xea, 0x02, 0x0a, 0x10, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x54, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x31, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65,
+	0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x76,
+	0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x13, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x12, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65,
+	0x5f, 0x76, 0x69, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x74, 0x69,
+	0x6d, 0x69, 0x7a, 0x65, 0x56, 0x69, 0x61, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x6c, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x22,
+	0x52, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x32, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x07, 0x50, 0x61, 0x74, 0x68, 0x56, 0x69, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x77, 0x65, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x22, 0xba, 0x04, 0x0a, 0x09, 0x50, 0x61, 0x74, 0x68, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x6f, 0x6c, 0x61,
+	0x72, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x28, 0x0a, 0x0d, 0x6a, 0x75, 0x6d, 0x70, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x0c, 0x6a, 0x75, 0x6d, 0x70, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0d, 0x77, 0x61, 0x72, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0c, 0x77, 0x61, 0x72, 0x70,
+	0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x08, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x74, 0x72, 0x75,
+	0x65, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x03, 0x52, 0x0c, 0x74, 0x72, 0x75, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x03, 0x76, 0x69,
+	0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x56, 0x69, 0x61, 0x52, 0x03,
+	0x76, 0x69, 0x61, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65,
+	0x62, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x63, 0x6f, 0x73, 0x74,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6a, 0x75, 0x6d, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x77, 0x61, 0x72, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x22, 0x60, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x34, 0x0a, 0x08, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x10,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x2b, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x50, 0x61,
+	0x74, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a,
+	0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65,
+	0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x43, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65,
+	0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x22, 0x52, 0x0a, 0x12, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xcd, 0x03, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x6a, 0x75, 0x6d, 0x70, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6a, 0x75,
+	0x6d, 0x70, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6a, 0x75, 0x6d, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6a, 0x75, 0x6d, 0x70,
+	0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x72, 0x70,
+	0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x77, 0x61, 0x72, 0x70, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x73, 0x65, 0x63, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x68,
+	0x69, 0x67, 0x68, 0x53, 0x65, 0x63, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x6e, 0x75, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x3a, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x4a,
+	0x75, 0x6d, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x61,
+	0x70, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x65, 0x6c, 0x61, 0x70,
+	0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x22, 0x0a,
+	0x0c, 0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xb5, 0x03, 0x0a, 0x11, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65,
+	0x52, 0x07, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x61, 0x6c, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52,
+	0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x34, 0x0a,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65,
+	0x62, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x63, 0x6f, 0x73, 0x74,
+	0x73, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x0d, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa8, 0x02, 0x0a, 0x12, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e,
+	0x0a, 0x05, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x41, 0x76, 0x6f,
+	0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x12, 0x44,
+	0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x77, 0x65, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77,
+	0x65, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x10, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x61,
+	0x74, 0x72, 0x69, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x75, 0x6d,
+	0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6a, 0x75, 0x6d, 0x70, 0x73, 0x12,
+	0x28, 0x0a, 0x0d, 0x6a, 0x75, 0x6d, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x75, 0x6d, 0x70, 0x44, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x77, 0x61, 0x72,
+	0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x01, 0x52, 0x0c, 0x77, 0x61, 0x72, 0x70, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6a, 0x75, 0x6d, 0x70, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x77, 0x61, 0x72, 0x70, 0x5f, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x62, 0x0a, 0x0e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x12, 0x38, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65,
+	0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x13, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x6f, 0x77, 0x52, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x15, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65,
+	0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x46, 0x72, 0x6f, 0x6d,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x6a, 0x75, 0x6d, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x4a, 0x75, 0x6d, 0x70, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x76, 0x6f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x41, 0x76, 0x6f, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x12, 0x44, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x54, 0x72,
+	0x61, 0x76, 0x65, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x31,
+	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x54, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x6f, 0x6c,
+	0x61, 0x72, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x75, 0x6d, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6a, 0x75, 0x6d, 0x70, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x6a, 0x75, 0x6d, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6a, 0x75, 0x6d, 0x70, 0x44, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x72, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x77, 0x61, 0x72, 0x70,
+	0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x52,
+	0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x16, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x2e, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x52, 0x07, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x52, 0x0a, 0x0c, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x71, 0x0a,
+	0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x65,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73,
+	0x22, 0x49, 0x0a, 0x0e, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x0d,
+	0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x72, 0x75, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x72, 0x75, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x79, 0x6e, 0x6f, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x79, 0x6e, 0x6f, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x22, 0x48, 0x0a, 0x0f, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x52, 0x07, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x46, 0x0a, 0x0d,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c,
+	0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01,
+	0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x22, 0xb5, 0x01, 0x0a, 0x0d, 0x47,
+	0x61, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x67, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x67, 0x61, 0x74, 0x65, 0x12,
+	0x41, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61,
+	0x74, 0x65, 0x22, 0x67, 0x0a, 0x12, 0x4a, 0x75, 0x6d, 0x70, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x6c, 0x61,
+	0x72, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x73, 0x6f, 0x6c, 0x61, 0x72, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xdd, 0x03, 0x0a, 0x0e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72,
+	0x75, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x74, 0x72, 0x75, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x32, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x05, 0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65,
+	0x62, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x52,
+	0x05, 0x67, 0x61, 0x74, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x15, 0x6a, 0x75, 0x6d, 0x70, 0x5f, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x5f, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x52, 0x13, 0x6a, 0x75, 0x6d, 0x70, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x12,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0xf7, 0x01, 0x0a, 0x05,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x04, 0x46, 0x69, 0x6e, 0x64, 0x12, 0x1e, 0x2e,
+	0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
+	0x0a, 0x06, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x76, 0x65,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d,
+	0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x09, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x65, 0x76, 0x65,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x97, 0x01, 0x0a, 0x08, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x2e,
+	0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x49, 0x6e, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x76,
+	0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x2e, 0x49, 0x6e, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x77, 0x65, 0x62, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x65,
+	0x72, 0x74, 0x73, 0x65, 0x68, 0x61, 0x2f, 0x65, 0x76, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2d,
+	0x77, 0x65, 0x62, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_route_proto_rawDescOnce sync.Once
+	file_route_proto_rawDescData = file_route_proto_rawDesc
+)
+
+func file_route_proto_rawDescGZIP() []byte {
+	file_route_proto_rawDescOnce.Do(func() {
+		file_route_proto_rawDescData = protoimpl.X.CompressGZIP(file_route_proto_rawDescData)
+	})
+	return file_route_proto_rawDescData
+}
+
+var file_route_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_route_proto_goTypes = []any{
+	(*FromEntry)(nil),                   // 0: everoute.web.FromEntry
+	(*TravelEntry)(nil),                 // 1: everoute.web.TravelEntry
+	(*AvoidEntry)(nil),                  // 2: everoute.web.AvoidEntry
+	(*NearestEntry)(nil),                // 3: everoute.web.NearestEntry
+	(*RouteEntry)(nil),                  // 4: everoute.web.RouteEntry
+	(*SecurityLimits)(nil),              // 5: everoute.web.SecurityLimits
+	(*JumpGateTravelCapability)(nil),    // 6: everoute.web.JumpGateTravelCapability
+	(*JumpDriveTravelCapability)(nil),   // 7: everoute.web.JumpDriveTravelCapability
+	(*TravelCapabilities)(nil),          // 8: everoute.web.TravelCapabilities
+	(*TravelRuleParameter)(nil),         // 9: everoute.web.TravelRuleParameter
+	(*SecurityTravelRuleParameter)(nil), // 10: everoute.web.SecurityTravelRuleParameter
+	(*TravelRuleset)(nil),               // 11: everoute.web.TravelRuleset
+	(*RouteFindRequest)(nil),            // 12: everoute.web.RouteFindRequest
+	(*Stargate)(nil),                    // 13: everoute.web.Stargate
+	(*PathVia)(nil),                     // 14: everoute.web.PathVia
+	(*PathEntry)(nil),                   // 15: everoute.web.PathEntry
+	(*RouteNotice)(nil),                 // 16: everoute.web.RouteNotice
+	(*RuleCost)(nil),                    // 17: everoute.web.RuleCost
+	(*RouteAlternative)(nil),            // 18: everoute.web.RouteAlternative
+	(*RouteSummaryRegion)(nil),          // 19: everoute.web.RouteSummaryRegion
+	(*RouteSummary)(nil),                // 20: everoute.web.RouteSummary
+	(*RouteSearchStatus)(nil),           // 21: everoute.web.RouteSearchStatus
+	(*RouteFindResponse)(nil),           // 22: everoute.web.RouteFindResponse
+	(*RouteMatrixRequest)(nil),          // 23: everoute.web.RouteMatrixRequest
+	(*RouteMatrixEntry)(nil),            // 24: everoute.web.RouteMatrixEntry
+	(*RouteMatrixRow)(nil),              // 25: everoute.web.RouteMatrixRow
+	(*RouteMatrixResponse)(nil),         // 26: everoute.web.RouteMatrixResponse
+	(*RouteReachableRequest)(nil),       // 27: everoute.web.RouteReachableRequest
+	(*ReachableSystem)(nil),             // 28: everoute.web.ReachableSystem
+	(*RouteReachableResponse)(nil),      // 29: everoute.web.RouteReachableResponse
+	(*ErrorProblem)(nil),                // 30: everoute.web.ErrorProblem
+	(*ErrorResponse)(nil),               // 31: everoute.web.ErrorResponse
+	(*InRangeRequest)(nil),              // 32: everoute.web.InRangeRequest
+	(*InRangeSystem)(nil),               // 33: everoute.web.InRangeSystem
+	(*InRangeResponse)(nil),             // 34: everoute.web.InRangeResponse
+	(*SystemRequest)(nil),               // 35: everoute.web.SystemRequest
+	(*Position)(nil),                    // 36: everoute.web.Position
+	(*GateNeighbour)(nil),               // 37: everoute.web.GateNeighbour
+	(*JumpDriveNeighbour)(nil),          // 38: everoute.web.JumpDriveNeighbour
+	(*SystemResponse)(nil),              // 39: everoute.web.SystemResponse
+	nil,                                 // 40: everoute.web.RouteSummary.JumpsEntry
+}
+var file_route_proto_depIdxs = []int32{
+	0,  // 0: everoute.web.RouteEntry.from:type_name -> everoute.web.FromEntry
+	1,  // 1: everoute.web.RouteEntry.via:type_name -> everoute.web.TravelEntry
+	1,  // 2: everoute.web.RouteEntry.to:type_name -> everoute.web.TravelEntry
+	2,  // 3: everoute.web.RouteEntry.avoid:type_name -> everoute.web.AvoidEntry
+	3,  // 4: everoute.web.RouteEntry.nearest:type_name -> everoute.web.NearestEntry
+	5,  // 5: everoute.web.JumpGateTravelCapability.security:type_name -> everoute.web.SecurityLimits
+	5,  // 6: everoute.web.JumpDriveTravelCapability.security:type_name -> everoute.web.SecurityLimits
+	6,  // 7: everoute.web.TravelCapabilities.jump_gate:type_name -> everoute.web.JumpGateTravelCapability
+	7,  // 8: everoute.web.TravelCapabilities.jump_drive:type_name -> everoute.web.JumpDriveTravelCapability
+	9,  // 9: everoute.web.TravelRuleset.transit_count:type_name -> everoute.web.TravelRuleParameter
+	10, // 10: everoute.web.TravelRuleset.min_security:type_name -> everoute.web.SecurityTravelRuleParameter
+	10, // 11: everoute.web.TravelRuleset.max_security:type_name -> everoute.web.SecurityTravelRuleParameter
+	9,  // 12: everoute.web.TravelRuleset.jump_distance:type_name -> everoute.web.TravelRuleParameter
+	9,  // 13: everoute.web.TravelRuleset.warp_distance:type_name -> everoute.web.TravelRuleParameter
+	4,  // 14: everoute.web.RouteFindRequest.route:type_name -> everoute.web.RouteEntry
+	8,  // 15: everoute.web.RouteFindRequest.capabilities:type_name -> everoute.web.TravelCapabilities
+	11, // 16: everoute.web.RouteFindRequest.rules:type_name -> everoute.web.TravelRuleset
+	36, // 17: everoute.web.Stargate.position:type_name -> everoute.web.Position
+	13, // 18: everoute.web.PathVia.stargate:type_name -> everoute.web.Stargate
+	14, // 19: everoute.web.PathEntry.via:type_name -> everoute.web.PathVia
+	17, // 20: everoute.web.PathEntry.costs:type_name -> everoute.web.RuleCost
+	15, // 21: everoute.web.RouteAlternative.path:type_name -> everoute.web.PathEntry
+	17, // 22: everoute.web.RouteAlternative.costs:type_name -> everoute.web.RuleCost
+	20, // 23: everoute.web.RouteAlternative.summary:type_name -> everoute.web.RouteSummary
+	40, // 24: everoute.web.RouteSummary.jumps:type_name -> everoute.web.RouteSummary.JumpsEntry
+	19, // 25: everoute.web.RouteSummary.regions:type_name -> everoute.web.RouteSummaryRegion
+	15, // 26: everoute.web.RouteFindResponse.path:type_name -> everoute.web.PathEntry
+	16, // 27: everoute.web.RouteFindResponse.notices:type_name -> everoute.web.RouteNotice
+	18, // 28: everoute.web.RouteFindResponse.alternatives:type_name -> everoute.web.RouteAlternative
+	20, // 29: everoute.web.RouteFindResponse.summary:type_name -> everoute.web.RouteSummary
+	17, // 30: everoute.web.RouteFindResponse.costs:type_name -> everoute.web.RuleCost
+	21, // 31: everoute.web.RouteFindResponse.status:type_name -> everoute.web.RouteSearchStatus
+	2,  // 32: everoute.web.RouteMatrixRequest.avoid:type_name -> everoute.web.AvoidEntry
+	8,  // 33: everoute.web.RouteMatrixRequest.capabilities:type_name -> everoute.web.TravelCapabilities
+	11, // 34: everoute.web.RouteMatrixRequest.rules:type_name -> everoute.web.TravelRuleset
+	24, // 35: everoute.web.RouteMatrixRow.entries:type_name -> everoute.web.RouteMatrixEntry
+	25, // 36: everoute.web.RouteMatrixResponse.rows:type_name -> everoute.web.RouteMatrixRow
+	0,  // 37: everoute.web.RouteReachableRequest.from:type_name -> everoute.web.FromEntry
+	2,  // 38: everoute.web.RouteReachableRequest.avoid:type_name -> everoute.web.AvoidEntry
+	8,  // 39: everoute.web.RouteReachableRequest.capabilities:type_name -> everoute.web.TravelCapabilities
+	11, // 40: everoute.web.RouteReachableRequest.rules:type_name -> everoute.web.TravelRuleset
+	17, // 41: everoute.web.ReachableSystem.costs:type_name -> everoute.web.RuleCost
+	28, // 42: everoute.web.RouteReachableResponse.systems:type_name -> everoute.web.ReachableSystem
+	30, // 43: everoute.web.ErrorResponse.problems:type_name -> everoute.web.ErrorProblem
+	33, // 44: everoute.web.InRangeResponse.systems:type_name -> everoute.web.InRangeSystem
+	36, // 45: everoute.web.GateNeighbour.gate:type_name -> everoute.web.Position
+	36, // 46: everoute.web.GateNeighbour.destination_gate:type_name -> everoute.web.Position
+	36, // 47: everoute.web.SystemResponse.position:type_name -> everoute.web.Position
+	37, // 48: everoute.web.SystemResponse.gates:type_name -> everoute.web.GateNeighbour
+	38, // 49: everoute.web.SystemResponse.jump_drive_neighbours:type_name -> everoute.web.JumpDriveNeighbour
+	12, // 50: everoute.web.Route.Find:input_type -> everoute.web.RouteFindRequest
+	23, // 51: everoute.web.Route.Matrix:input_type -> everoute.web.RouteMatrixRequest
+	27, // 52: everoute.web.Route.Reachable:input_type -> everoute.web.RouteReachableRequest
+	32, // 53: everoute.web.Universe.InRange:input_type -> everoute.web.InRangeRequest
+	35, // 54: everoute.web.Universe.System:input_type -> everoute.web.SystemRequest
+	22, // 55: everoute.web.Route.Find:output_type -> everoute.web.RouteFindResponse
+	26, // 56: everoute.web.Route.Matrix:output_type -> everoute.web.RouteMatrixResponse
+	29, // 57: everoute.web.Route.Reachable:output_type -> everoute.web.RouteReachableResponse
+	34, // 58: everoute.web.Universe.InRange:output_type -> everoute.web.InRangeResponse
+	39, // 59: everoute.web.Universe.System:output_type -> everoute.web.SystemResponse
+	55, // [55:60] is the sub-list for method output_type
+	50, // [50:55] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
+}
+
+func init() { file_route_proto_init() }
+func file_route_proto_init() {
+	if File_route_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_route_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*FromEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*TravelEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*AvoidEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*NearestEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*SecurityLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*JumpGateTravelCapability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*JumpDriveTravelCapability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*TravelCapabilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*TravelRuleParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*SecurityTravelRuleParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*TravelRuleset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteFindRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*Stargate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*PathVia); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*PathEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteNotice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*RuleCost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteAlternative); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteSummaryRegion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteSearchStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteFindResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteMatrixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteMatrixEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteMatrixRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteMatrixResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteReachableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ReachableSystem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteReachableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ErrorProblem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ErrorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*InRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*InRangeSystem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*InRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*SystemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*Position); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*GateNeighbour); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*JumpDriveNeighbour); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_route_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*SystemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_route_proto_msgTypes[5].OneofWrappers = []any{}
+	file_route_proto_msgTypes[12].OneofWrappers = []any{}
+	file_route_proto_msgTypes[15].OneofWrappers = []any{}
+	file_route_proto_msgTypes[24].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_route_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   41,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_route_proto_goTypes,
+		DependencyIndexes: file_route_proto_depIdxs,
+		MessageInfos:      file_route_proto_msgTypes,
+	}.Build()
+	File_route_proto = out.File
+	file_route_proto_rawDesc = nil
+	file_route_proto_goTypes = nil
+	file_route_proto_depIdxs = nil
+}
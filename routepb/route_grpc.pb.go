@@ -0,0 +1,340 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: route.proto
+
+// Messages of this package correspond to the types of the api package.
+// Solar systems are identified by their ID.
+
+package routepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Route_Find_FullMethodName      = "/everoute.web.Route/Find"
+	Route_Matrix_FullMethodName    = "/everoute.web.Route/Matrix"
+	Route_Reachable_FullMethodName = "/everoute.web.Route/Reachable"
+)
+
+// RouteClient is the client API for Route service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RouteClient interface {
+	Find(ctx context.Context, in *RouteFindRequest, opts ...grpc.CallOption) (*RouteFindResponse, error)
+	Matrix(ctx context.Context, in *RouteMatrixRequest, opts ...grpc.CallOption) (*RouteMatrixResponse, error)
+	Reachable(ctx context.Context, in *RouteReachableRequest, opts ...grpc.CallOption) (*RouteReachableResponse, error)
+}
+
+type routeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRouteClient(cc grpc.ClientConnInterface) RouteClient {
+	return &routeClient{cc}
+}
+
+func (c *routeClient) Find(ctx context.Context, in *RouteFindRequest, opts ...grpc.CallOption) (*RouteFindResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RouteFindResponse)
+	err := c.cc.Invoke(ctx, Route_Find_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeClient) Matrix(ctx context.Context, in *RouteMatrixRequest, opts ...grpc.CallOption) (*RouteMatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RouteMatrixResponse)
+	err := c.cc.Invoke(ctx, Route_Matrix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeClient) Reachable(ctx context.Context, in *RouteReachableRequest, opts ...grpc.CallOption) (*RouteReachableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RouteReachableResponse)
+	err := c.cc.Invoke(ctx, Route_Reachable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RouteServer is the server API for Route service.
+// All implementations must embed UnimplementedRouteServer
+// for forward compatibility.
+type RouteServer interface {
+	Find(context.Context, *RouteFindRequest) (*RouteFindResponse, error)
+	Matrix(context.Context, *RouteMatrixRequest) (*RouteMatrixResponse, error)
+	Reachable(context.Context, *RouteReachableRequest) (*RouteReachableResponse, error)
+	mustEmbedUnimplementedRouteServer()
+}
+
+// UnimplementedRouteServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRouteServer struct{}
+
+func (UnimplementedRouteServer) Find(context.Context, *RouteFindRequest) (*RouteFindResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Find not implemented")
+}
+func (UnimplementedRouteServer) Matrix(context.Context, *RouteMatrixRequest) (*RouteMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Matrix not implemented")
+}
+func (UnimplementedRouteServer) Reachable(context.Context, *RouteReachableRequest) (*RouteReachableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reachable not implemented")
+}
+func (UnimplementedRouteServer) mustEmbedUnimplementedRouteServer() {}
+func (UnimplementedRouteServer) testEmbeddedByValue()               {}
+
+// UnsafeRouteServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RouteServer will
+// result in compilation errors.
+type UnsafeRouteServer interface {
+	mustEmbedUnimplementedRouteServer()
+}
+
+func RegisterRouteServer(s grpc.ServiceRegistrar, srv RouteServer) {
+	// If the following call pancis, it indicates UnimplementedRouteServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Route_ServiceDesc, srv)
+}
+
+func _Route_Find_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteFindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteServer).Find(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Route_Find_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteServer).Find(ctx, req.(*RouteFindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Route_Matrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteServer).Matrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Route_Matrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteServer).Matrix(ctx, req.(*RouteMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Route_Reachable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteReachableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteServer).Reachable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Route_Reachable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteServer).Reachable(ctx, req.(*RouteReachableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Route_ServiceDesc is the grpc.ServiceDesc for Route service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Route_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "everoute.web.Route",
+	HandlerType: (*RouteServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Find",
+			Handler:    _Route_Find_Handler,
+		},
+		{
+			MethodName: "Matrix",
+			Handler:    _Route_Matrix_Handler,
+		},
+		{
+			MethodName: "Reachable",
+			Handler:    _Route_Reachable_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "route.proto",
+}
+
+const (
+	Universe_InRange_FullMethodName = "/everoute.web.Universe/InRange"
+	Universe_System_FullMethodName  = "/everoute.web.Universe/System"
+)
+
+// UniverseClient is the client API for Universe service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UniverseClient interface {
+	InRange(ctx context.Context, in *InRangeRequest, opts ...grpc.CallOption) (*InRangeResponse, error)
+	System(ctx context.Context, in *SystemRequest, opts ...grpc.CallOption) (*SystemResponse, error)
+}
+
+type universeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUniverseClient(cc grpc.ClientConnInterface) UniverseClient {
+	return &universeClient{cc}
+}
+
+func (c *universeClient) InRange(ctx context.Context, in *InRangeRequest, opts ...grpc.CallOption) (*InRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InRangeResponse)
+	err := c.cc.Invoke(ctx, Universe_InRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) System(ctx context.Context, in *SystemRequest, opts ...grpc.CallOption) (*SystemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemResponse)
+	err := c.cc.Invoke(ctx, Universe_System_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UniverseServer is the server API for Universe service.
+// All implementations must embed UnimplementedUniverseServer
+// for forward compatibility.
+type UniverseServer interface {
+	InRange(context.Context, *InRangeRequest) (*InRangeResponse, error)
+	System(context.Context, *SystemRequest) (*SystemResponse, error)
+	mustEmbedUnimplementedUniverseServer()
+}
+
+// UnimplementedUniverseServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUniverseServer struct{}
+
+func (UnimplementedUniverseServer) InRange(context.Context, *InRangeRequest) (*InRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InRange not implemented")
+}
+func (UnimplementedUniverseServer) System(context.Context, *SystemRequest) (*SystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method System not implemented")
+}
+func (UnimplementedUniverseServer) mustEmbedUnimplementedUniverseServer() {}
+func (UnimplementedUniverseServer) testEmbeddedByValue()                  {}
+
+// UnsafeUniverseServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UniverseServer will
+// result in compilation errors.
+type UnsafeUniverseServer interface {
+	mustEmbedUnimplementedUniverseServer()
+}
+
+func RegisterUniverseServer(s grpc.ServiceRegistrar, srv UniverseServer) {
+	// If the following call pancis, it indicates UnimplementedUniverseServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Universe_ServiceDesc, srv)
+}
+
+func _Universe_InRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).InRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Universe_InRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).InRange(ctx, req.(*InRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_System_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).System(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Universe_System_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).System(ctx, req.(*SystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Universe_ServiceDesc is the grpc.ServiceDesc for Universe service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Universe_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "everoute.web.Universe",
+	HandlerType: (*UniverseServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InRange",
+			Handler:    _Universe_InRange_Handler,
+		},
+		{
+			MethodName: "System",
+			Handler:    _Universe_System_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "route.proto",
+}